@@ -319,6 +319,89 @@ type APIMeta struct {
 	VerifiablePasswordAuthentication *bool `json:"verifiable_password_authentication,omitempty"`
 }
 
+// AccessAuditor reports who has access to the repositories of an
+// organization and how. Owners are found with OrganizationsService.ListMembers
+// filtered on the admin role, and team grants with ListTeams, ListTeamMembers
+// and ListTeamRepos, the permission of a team being the Permissions of the
+// repository returned by ListTeamRepos. Direct grants are listed with
+// RepositoriesService.ListCollaborators using the "direct" affiliation, and
+// their permission is read from User.Permissions. Each path is reported on its
+// own, so a team member who also has a direct grant appears in both.
+
+// AccessAuditor 报告谁能访问某组织的仓库以及通过何种途径访问.
+// 拥有者通过以 admin 角色过滤的 OrganizationsService.ListMembers 查找,
+// 团队授权通过 ListTeams, ListTeamMembers 和 ListTeamRepos 查找, 团队的权限为
+// ListTeamRepos 所返回仓库的 Permissions. 直接授权通过以 "direct" 隶属关系调用
+// RepositoriesService.ListCollaborators 罗列, 其权限读取自 User.Permissions.
+// 每条途径单独报告, 因此同时具有直接授权的团队成员在两者中都会出现.
+type AccessAuditor struct {
+	// contains filtered or unexported fields
+}
+
+// NewAccessAuditor returns an AccessAuditor that uses client. The client must
+// be authenticated as an owner of the audited organizations to see private
+// repositories, concealed members and secret teams.
+
+// NewAccessAuditor 返回使用 client 的 AccessAuditor. client 必须以被审计组织
+// 拥有者的身份授权, 才能看到私有仓库, 隐藏成员和秘密团队.
+func NewAccessAuditor(client *Client) *AccessAuditor
+
+// Audit walks every repository of org and reports each access path of each
+// user.
+
+// Audit 遍历 org 的每个仓库, 并报告每个用户的每条访问途径.
+func (a *AccessAuditor) Audit(org string) (*AccessReport, *Response, error)
+
+// AccessGrant represents one path through which a user has access to a
+// repository. A user with several paths to the same repository appears in
+// several grants.
+
+// AccessGrant 表示用户访问某仓库的一条途径. 通过多条途径访问同一仓库的用户
+// 出现在多个授权中.
+type AccessGrant struct {
+	Repo string `json:"repo"`
+	User string `json:"user"`
+
+	// Via is one of "owner", "team" or "direct".
+
+	// Via 为 "owner", "team" 或 "direct" 之一.
+	Via string `json:"via"`
+
+	// Team is the slug of the team for grants via a team.
+
+	// 对于通过团队的授权, Team 为团队的 slug.
+	Team string `json:"team,omitempty"`
+
+	// Permission is one of "pull", "push" or "admin", the highest permission
+	// granted through this path. Owners always have "admin".
+
+	// Permission 为 "pull", "push" 或 "admin" 之一, 为通过该途径授予的最高权限.
+	// 拥有者总是 "admin".
+	Permission string `json:"permission"`
+}
+
+// AccessReport is the result of an AccessAuditor.Audit. Grants are sorted by
+// repository, then user.
+
+// AccessReport 为 AccessAuditor.Audit 的结果. Grants 依次以仓库, 用户排序.
+type AccessReport struct {
+	Org         string        `json:"org"`
+	GeneratedAt time.Time     `json:"generated_at"`
+	Grants      []AccessGrant `json:"grants"`
+}
+
+// WriteCSV writes the grants of r to w as CSV, with a
+// "repo,user,via,team,permission" header row.
+
+// WriteCSV 将 r 的授权以 CSV 格式写入 w, 含 "repo,user,via,team,permission"
+// 标题行.
+func (r *AccessReport) WriteCSV(w io.Writer) error
+
+// WriteJSON writes r to w as an indented JSON document.
+
+// WriteJSON 将 r 以缩进的 JSON 文档写入 w.
+func (r *AccessReport) WriteJSON(w io.Writer) error
+
 // ActivityListStarredOptions specifies the optional parameters to the
 // ActivityService.ListStarred method.
 
@@ -484,6 +567,43 @@ func (s *ActivityService) ListEventsPerformedByUser(user string, publicOnly bool
 // http://developer.github.com/v3/activity/events/#list-events-that-a-user-has-received
 func (s *ActivityService) ListEventsRecievedByUser(user string, publicOnly bool, opt *ListOptions) ([]Event, *Response, error)
 
+// ListFeeds lists all the feeds available to the authenticated user.
+//
+// GitHub provides several timeline resources in Atom format:
+//
+//	Timeline: The GitHub global public timeline
+//	User: The public timeline for any user, using URI template
+//	Current user public: The public timeline for the authenticated user
+//	Current user: The private timeline for the authenticated user
+//	Current user actor: The private timeline for activity created by the
+//	    authenticated user
+//	Current user organizations: The private timeline for the organizations
+//	    the authenticated user is a member of.
+//
+// Note: Private feeds are only returned when authenticating via Basic Auth
+// since current feed URIs use the older, non revocable auth tokens.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/activity/feeds/#list-feeds
+
+// ListFeeds 罗列授权用户所有可用的 feed.
+//
+// GitHub 以 Atom 格式提供以下几种时间线资源:
+//
+//	Timeline: GitHub 全局公共时间线
+//	User: 任意用户的公共时间线, 使用 URI 模板
+//	Current user public: 授权用户的公共时间线
+//	Current user: 授权用户的私有时间线
+//	Current user actor: 授权用户所创建活动的私有时间线
+//	Current user organizations: 授权用户所属组织的私有时间线.
+//
+// Note: 只有通过 Basic Auth 认证时才返回私有 feed,
+// 因为当前 feed URI 使用较老的, 不可撤销的认证 token.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/activity/feeds/#list-feeds
+func (s *ActivityService) ListFeeds() (*Feeds, *Response, error)
+
 // ListIssueEventsForRepository lists issue events for a repository.
 //
 // GitHub API docs:
@@ -666,6 +786,60 @@ func (s *ActivityService) Star(owner, repo string) (*Response, error)
 // https://developer.github.com/v3/activity/starring/#unstar-a-repository
 func (s *ActivityService) Unstar(owner, repo string) (*Response, error)
 
+// AtomEntry represents a single entry of an Atom feed.
+
+// AtomEntry 表示 Atom feed 中的单个条目.
+type AtomEntry struct {
+	ID        *string     `xml:"id,omitempty"`
+	Title     *string     `xml:"title,omitempty"`
+	Published *time.Time  `xml:"published,omitempty"`
+	Updated   *time.Time  `xml:"updated,omitempty"`
+	Links     []AtomLink  `xml:"link,omitempty"`
+	Author    *AtomPerson `xml:"author,omitempty"`
+	Content   *string     `xml:"content,omitempty"`
+}
+
+func (e AtomEntry) String() string
+
+// AtomFeed represents a parsed Atom feed, such as one of the timelines
+// returned by ActivityService.ListFeeds.
+
+// AtomFeed 表示一个已解析的 Atom feed, 比如 ActivityService.ListFeeds
+// 返回的某个时间线.
+type AtomFeed struct {
+	ID      *string     `xml:"id,omitempty"`
+	Title   *string     `xml:"title,omitempty"`
+	Updated *time.Time  `xml:"updated,omitempty"`
+	Links   []AtomLink  `xml:"link,omitempty"`
+	Entries []AtomEntry `xml:"entry,omitempty"`
+}
+
+// ParseAtomFeed parses the Atom document read from r. Use it on the body
+// of a feed fetched from one of the Feeds URLs.
+
+// ParseAtomFeed 解析从 r 读取的 Atom 文档.
+// 可用于解析从 Feeds 中某个 URL 获取的 feed 内容.
+func ParseAtomFeed(r io.Reader) (*AtomFeed, error)
+
+func (f AtomFeed) String() string
+
+// AtomLink represents a link element of an Atom feed or entry.
+
+// AtomLink 表示 Atom feed 或条目中的链接元素.
+type AtomLink struct {
+	HRef *string `xml:"href,attr,omitempty"`
+	Rel  *string `xml:"rel,attr,omitempty"`
+	Type *string `xml:"type,attr,omitempty"`
+}
+
+// AtomPerson represents the author of an Atom entry.
+
+// AtomPerson 表示 Atom 条目的作者.
+type AtomPerson struct {
+	Name *string `xml:"name,omitempty"`
+	URI  *string `xml:"uri,omitempty"`
+}
+
 // Blob represents a blob object.
 
 // Blob 表示一个 blob 对象.
@@ -685,6 +859,16 @@ type Branch struct {
 	Commit *Commit `json:"commit,omitempty"`
 }
 
+// BurndownPoint holds the number of open and closed issues of a milestone at
+// the end of a day.
+
+// BurndownPoint 保存某里程碑在一天结束时打开和关闭的问题数.
+type BurndownPoint struct {
+	Date   time.Time
+	Open   int
+	Closed int
+}
+
 // A Client manages communication with the GitHub API.
 
 // Client 管理一个 GitHub API 通信.
@@ -724,8 +908,10 @@ type Client struct {
 	Git           *GitService
 	Gitignores    *GitignoresService
 	Issues        *IssuesService
+	Licenses      *LicensesService
 	Organizations *OrganizationsService
 	PullRequests  *PullRequestsService
+	Reactions     *ReactionsService
 	Repositories  *RepositoriesService
 	Search        *SearchService
 	Users         *UsersService
@@ -884,6 +1070,12 @@ type Commit struct {
 	Stats     *CommitStats  `json:"stats,omitempty"`
 	URL       *string       `json:"url,omitempty"`
 
+	// Verification holds the signature of the commit and whether GitHub
+	// could verify it.
+
+	// Verification 包含提交的签名以及 GitHub 是否能够验证它.
+	Verification *SignatureVerification `json:"verification,omitempty"`
+
 	// CommentCount is the number of GitHub comments on the commit.  This
 	// is only populated for requests that fetch GitHub data like
 	// Pulls.ListCommits, Repositories.ListCommits, etc.
@@ -907,6 +1099,85 @@ type CommitAuthor struct {
 
 func (c CommitAuthor) String() string
 
+// CommitBuilder creates a single commit on a branch out of a set of file
+// operations. Commit performs the GitService plumbing calls in order:
+// CreateBlob for each added or modified file, CreateTree against the tree of
+// the branch head, CreateCommit with the head as parent, and finally a
+// non-forced UpdateRef. Deleted paths, and the old path of each renamed file,
+// are sent to CreateTree as TreeEntry values with no SHA and no Content, which
+// TreeEntry.MarshalJSON encodes as "sha": null to remove them from the base
+// tree. If UpdateRef fails because the branch moved in the
+// meantime, the operations are replayed on top of the new head and the update
+// is retried, up to MaxRetries times.
+//
+// Blobs and trees created by a failed Commit are never referenced by the
+// branch and are eventually garbage collected by GitHub.
+
+// CommitBuilder 以一组文件操作在某分支上创建单个提交. Commit 依次执行
+// GitService 底层调用: 为每个增加或修改的文件调用 CreateBlob, 基于分支头部的树
+// 调用 CreateTree, 以头部为父提交调用 CreateCommit, 最后执行非强制的
+// UpdateRef. 删除的路径以及每个重命名文件的原路径, 以既无 SHA 也无 Content 的
+// TreeEntry 传递给 CreateTree, TreeEntry.MarshalJSON 将其编码为 "sha": null,
+// 以便从基础树中删除. 如果因分支在此期间移动导致 UpdateRef 失败, 操作会在新的头部之上
+// 重放并重试更新, 最多 MaxRetries 次.
+//
+// 失败的 Commit 所创建的 blob 和树永远不会被分支引用, 最终会被 GitHub 垃圾回收.
+type CommitBuilder struct {
+	// Author and Committer are optional. See GitService.CreateCommit for how
+	// they are filled in when omitted.
+
+	// Author 和 Committer 是可选的. 省略时如何填充详见 GitService.CreateCommit.
+	Author    *CommitAuthor
+	Committer *CommitAuthor
+
+	// MaxRetries is the number of times the update of the branch is retried
+	// after a non-fast-forward failure. Default is 3.
+
+	// MaxRetries 为非快进失败后重试更新分支的次数. 缺省为 3.
+	MaxRetries int
+	// contains filtered or unexported fields
+}
+
+// NewCommitBuilder returns a CommitBuilder that commits to branch of the
+// owner/repo repository.
+
+// NewCommitBuilder 返回一个向 owner/repo 仓库的 branch 分支提交的 CommitBuilder.
+func NewCommitBuilder(client *Client, owner, repo, branch string) *CommitBuilder
+
+// Add adds a new file at path. Commit fails if path already exists.
+
+// Add 在 path 处增加新文件. 如果 path 已存在, Commit 会失败.
+func (b *CommitBuilder) Add(path string, content []byte)
+
+// Commit creates the commit with the given message and moves the branch to
+// it. The builder can not be reused after a successful Commit.
+
+// Commit 以给定的 message 创建提交并将分支移动到该提交.
+// 成功 Commit 之后 builder 不能再次使用.
+func (b *CommitBuilder) Commit(message string) (*Commit, *Response, error)
+
+// Delete removes the file at path. Commit fails if path does not exist. The
+// path is removed from the tree with a null-SHA TreeEntry.
+
+// Delete 删除 path 处的文件. 如果 path 不存在, Commit 会失败.
+// 该路径通过 SHA 为 null 的 TreeEntry 从树中删除.
+func (b *CommitBuilder) Delete(path string)
+
+// Modify replaces the content of the file at path. Commit fails if path does
+// not exist.
+
+// Modify 替换 path 处文件的内容. 如果 path 不存在, Commit 会失败.
+func (b *CommitBuilder) Modify(path string, content []byte)
+
+// Rename moves the file at from to to, keeping its content and mode. Commit
+// fails if from does not exist or to already exists. The tree gets an entry
+// for to with the blob SHA of from, and a null-SHA TreeEntry for from.
+
+// Rename 将 from 处的文件移动到 to, 保留其内容和模式.
+// 如果 from 不存在或 to 已存在, Commit 会失败. 树中会增加一个以 from 的
+// blob SHA 指向 to 的条目, 以及一个 SHA 为 null 的 from 条目.
+func (b *CommitBuilder) Rename(from, to string)
+
 // CommitFile represents a file modified in a commit.
 
 // CommitFile 表示提交中的某文件变更.
@@ -1025,6 +1296,37 @@ type ContributorStats struct {
 
 func (c ContributorStats) String() string
 
+// CreateOrgInvitationOptions specifies the parameters to the
+// OrganizationsService.CreateOrgInvitation method.
+
+// CreateOrgInvitationOptions 指定 OrganizationsService.CreateOrgInvitation
+// 方法的参数.
+type CreateOrgInvitationOptions struct {
+	// InviteeID is the GitHub user ID of the person being invited. Required
+	// unless Email is provided.
+
+	// InviteeID 为被邀请人的 GitHub 用户 ID. 除非提供了 Email, 否则必须提供.
+	InviteeID *int `json:"invitee_id,omitempty"`
+
+	// Email is the email address of the person being invited. Required unless
+	// InviteeID is provided.
+
+	// Email 为被邀请人的电子邮件地址. 除非提供了 InviteeID, 否则必须提供.
+	Email *string `json:"email,omitempty"`
+
+	// Role of the new member. Possible values are: admin, direct_member,
+	// billing_manager. Default is "direct_member".
+
+	// 新成员的角色. 可能的值有: admin, direct_member, billing_manager.
+	// 缺省为 "direct_member".
+	Role *string `json:"role,omitempty"`
+
+	// TeamID lists the teams the new member is added to.
+
+	// TeamID 罗列新成员被加入的团队.
+	TeamID []int `json:"team_ids,omitempty"`
+}
+
 // Deployment represents a deployment in a repo
 
 // Deployment 表示某仓库的部署信息
@@ -1105,6 +1407,42 @@ type DeploymentsListOptions struct {
 	ListOptions
 }
 
+// DiscussionComment represents a comment on a team discussion.
+
+// DiscussionComment 表示团队讨论中的一条评论.
+type DiscussionComment struct {
+	Author        *User      `json:"author,omitempty"`
+	Body          *string    `json:"body,omitempty"`
+	BodyHTML      *string    `json:"body_html,omitempty"`
+	BodyVersion   *string    `json:"body_version,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	LastEditedAt  *Timestamp `json:"last_edited_at,omitempty"`
+	DiscussionURL *string    `json:"discussion_url,omitempty"`
+	HTMLURL       *string    `json:"html_url,omitempty"`
+	Number        *int       `json:"number,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	URL           *string    `json:"url,omitempty"`
+	Reactions     *Reactions `json:"reactions,omitempty"`
+}
+
+func (c DiscussionComment) String() string
+
+// DiscussionListOptions specifies the optional parameters to the
+// OrganizationsService.ListTeamDiscussions and
+// OrganizationsService.ListTeamDiscussionComments methods.
+
+// DiscussionListOptions 指定 OrganizationsService.ListTeamDiscussions 和
+// OrganizationsService.ListTeamDiscussionComments 方法的可选参数.
+type DiscussionListOptions struct {
+	// Direction in which to sort discussions or comments. Possible values
+	// are: asc, desc. Default is "desc".
+
+	// 讨论或评论排序的方向. 可能的值有: asc, desc. 缺省为 "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListOptions
+}
+
 // An Error reports more details on an individual error in an ErrorResponse. These
 // are the possible validation error codes:
 //
@@ -1178,6 +1516,38 @@ func (e *Event) Payload() (payload interface{})
 
 func (e Event) String() string
 
+// FeedLink represents a link to a related resource.
+
+// FeedLink 表示一个相关资源的链接.
+type FeedLink struct {
+	HRef *string `json:"href,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// Feeds represents timeline resources in Atom format.
+
+// Feeds 表示 Atom 格式的时间线资源.
+type Feeds struct {
+	TimelineURL                 *string  `json:"timeline_url,omitempty"`
+	UserURL                     *string  `json:"user_url,omitempty"`
+	CurrentUserPublicURL        *string  `json:"current_user_public_url,omitempty"`
+	CurrentUserURL              *string  `json:"current_user_url,omitempty"`
+	CurrentUserActorURL         *string  `json:"current_user_actor_url,omitempty"`
+	CurrentUserOrganizationURL  *string  `json:"current_user_organization_url,omitempty"`
+	CurrentUserOrganizationURLs []string `json:"current_user_organization_urls,omitempty"`
+	Links                       *struct {
+		Timeline                 *FeedLink  `json:"timeline,omitempty"`
+		User                     *FeedLink  `json:"user,omitempty"`
+		CurrentUserPublic        *FeedLink  `json:"current_user_public,omitempty"`
+		CurrentUser              *FeedLink  `json:"current_user,omitempty"`
+		CurrentUserActor         *FeedLink  `json:"current_user_actor,omitempty"`
+		CurrentUserOrganization  *FeedLink  `json:"current_user_organization,omitempty"`
+		CurrentUserOrganizations []FeedLink `json:"current_user_organizations,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (f Feeds) String() string
+
 // Gist represents a GitHub's gist.
 
 // Gist 表示一个 GitHub's gist.
@@ -1193,6 +1563,16 @@ type Gist struct {
 	GitPushURL  *string                   `json:"git_push_url,omitempty"`
 	CreatedAt   *time.Time                `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time                `json:"updated_at,omitempty"`
+
+	// Truncated reports whether Files lists only part of the files of the
+	// gist, which happens when it has more than 300 files. Unlike
+	// GistFile.Truncated, this can not be fixed with GistsService.DownloadFile:
+	// the missing files are only available by cloning GitPullURL with git.
+
+	// Truncated 报告 Files 是否只罗列了 gist 的部分文件, 这在 gist 超过
+	// 300 个文件时发生. 与 GistFile.Truncated 不同, 这无法通过
+	// GistsService.DownloadFile 解决: 缺失的文件只能通过 git 克隆 GitPullURL 获得.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g Gist) String() string
@@ -1210,6 +1590,34 @@ type GistComment struct {
 
 func (g GistComment) String() string
 
+// GistCommit represents a commit on a gist.
+
+// GistCommit 表示 gist 上的一个提交.
+type GistCommit struct {
+	URL          *string      `json:"url,omitempty"`
+	Version      *string      `json:"version,omitempty"`
+	User         *User        `json:"user,omitempty"`
+	ChangeStatus *CommitStats `json:"change_status,omitempty"`
+	CommittedAt  *Timestamp   `json:"committed_at,omitempty"`
+}
+
+func (gc GistCommit) String() string
+
+// GistDownloadOptions specifies the optional parameters to the
+// GistsService.DownloadFile method.
+
+// GistDownloadOptions 指定 GistsService.DownloadFile 方法的可选参数.
+type GistDownloadOptions struct {
+	// MaxSize caps the number of bytes read from a file. Reading past the cap
+	// returns an error. The cap applies whether the content is streamed from
+	// RawURL or served from GistFile.Content. Zero means no limit.
+
+	// MaxSize 限定从文件读取的最大字节数. 超出限定读取会返回错误.
+	// 无论内容是从 RawURL 流式读取还是直接来自 GistFile.Content, 该限定都适用.
+	// 零值表示不限制.
+	MaxSize int64
+}
+
 // GistFile represents a file on a gist.
 
 // GistFile 表示 gist 上的某个文件.
@@ -1218,6 +1626,13 @@ type GistFile struct {
 	Filename *string `json:"filename,omitempty"`
 	RawURL   *string `json:"raw_url,omitempty"`
 	Content  *string `json:"content,omitempty"`
+
+	// Truncated reports whether Content holds only the beginning of the
+	// file. Use GistsService.DownloadFile to read the complete content.
+
+	// Truncated 报告 Content 是否只包含文件的开头部分.
+	// 使用 GistsService.DownloadFile 读取完整内容.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g GistFile) String() string
@@ -1227,6 +1642,19 @@ func (g GistFile) String() string
 // GistFilename 表示 gist 上的文件名.
 type GistFilename string
 
+// GistFork represents a fork of a gist.
+
+// GistFork 表示某个 gist 的分叉.
+type GistFork struct {
+	URL       *string    `json:"url,omitempty"`
+	User      *User      `json:"user,omitempty"`
+	ID        *string    `json:"id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (gf GistFork) String() string
+
 // GistListOptions specifies the optional parameters to the GistsService.List,
 // GistsService.ListAll, and GistsService.ListStarred methods.
 
@@ -1241,6 +1669,137 @@ type GistListOptions struct {
 	ListOptions
 }
 
+// GistRequest represents a request to edit the files of a gist. It is separate
+// from Gist because a file to delete must serialize to null, which a GistFile
+// value in Gist.Files can not do. A nil entry in Files deletes the file; an
+// entry with a new Filename renames it.
+
+// GistRequest 表示编辑 gist 文件的请求. 它独立于 Gist, 因为要删除的文件必须
+// 序列化为 null, 而 Gist.Files 中的 GistFile 值做不到. Files 中的 nil 条目
+// 删除该文件; 含有新 Filename 的条目重命名该文件.
+type GistRequest struct {
+	Description *string                    `json:"description,omitempty"`
+	Files       map[GistFilename]*GistFile `json:"files,omitempty"`
+}
+
+// GistSyncChange describes a single file change computed by a GistSyncer.
+
+// GistSyncChange 描述 GistSyncer 计算出的单个文件变更.
+type GistSyncChange struct {
+	Filename GistFilename
+
+	// Action is the change to apply. Possible values are: "upload" (added or
+	// edited locally), "download" (edited remotely), "delete-remote" (removed
+	// locally), "delete-local" (removed remotely), and "conflict" (changed on
+	// both sides since the last sync). "conflict" is only used in
+	// GistSyncPlan.Conflicts.
+
+	// Action 为要执行的变更. 可选值: "upload" (本地增加或编辑),
+	// "download" (远程编辑), "delete-remote" (本地已删除),
+	// "delete-local" (远程已删除), "conflict" (上次同步后两端都有变更).
+	// "conflict" 只用于 GistSyncPlan.Conflicts.
+	Action string
+}
+
+func (c GistSyncChange) String() string
+
+// GistSyncPlan is the set of changes needed to bring a local directory and a
+// gist in sync.
+
+// GistSyncPlan 表示使本地目录与 gist 同步所需的变更集合.
+type GistSyncPlan struct {
+	// Changes lists the changes to apply. It never holds a "conflict" entry.
+
+	// Changes 罗列要执行的变更. 它从不包含 "conflict" 条目.
+	Changes []GistSyncChange
+
+	// Conflicts lists the files changed on both sides since the last sync.
+	// They are reported but never applied. A file appears either in Changes
+	// or in Conflicts, never in both.
+
+	// Conflicts 罗列上次同步后两端都有变更的文件. 它们只会被报告, 不会被执行.
+	// 一个文件要么出现在 Changes 中, 要么出现在 Conflicts 中, 不会同时出现.
+	Conflicts []GistSyncChange
+}
+
+// GistSyncState records the state of a gist at the last successful sync. It
+// is meant to be persisted by the caller between runs.
+
+// GistSyncState 记录上次成功同步时 gist 的状态. 调用者应在多次运行间保存它.
+type GistSyncState struct {
+	GistID *string `json:"gist_id,omitempty"`
+
+	// Version is the gist revision that was last synced.
+
+	// Version 为上次同步的 gist 修订版本.
+	Version *string `json:"version,omitempty"`
+
+	// Files maps each synced filename to the SHA-1 of its content.
+
+	// Files 映射每个已同步文件名到其内容的 SHA-1.
+	Files map[GistFilename]string `json:"files,omitempty"`
+}
+
+// GistSyncer synchronizes the regular files of a local directory with the
+// files of a gist, using GistsService.Get, Create and EditFiles. Files are
+// compared against the GistSyncState of the previous sync to decide which side
+// changed. Files removed locally are deleted from the gist with a nil entry in
+// GistRequest.Files.
+//
+// Remote content is always read with GistsService.DownloadFile, so files whose
+// GistFile.Truncated is set are downloaded in full rather than from their
+// partial Content. A gist whose Gist.Truncated is set does not list all of its
+// files, so the missing ones would look removed remotely; Plan and Sync return
+// an error for such a gist without changing anything.
+
+// GistSyncer 使用 GistsService.Get, Create 和 EditFiles 同步本地目录中的常规
+// 文件与 gist 中的文件. 文件与上次同步的 GistSyncState 进行比较以确定哪端有变更.
+// 本地删除的文件通过 GistRequest.Files 中的 nil 条目从 gist 中删除.
+//
+// 远程内容总是通过 GistsService.DownloadFile 读取, 因此 GistFile.Truncated
+// 被设置的文件会被完整下载, 而不是使用其部分 Content. Gist.Truncated 被设置的
+// gist 并未罗列其全部文件, 缺失的文件会被视为远程已删除; 对于这种 gist,
+// Plan 和 Sync 返回错误, 不做任何变更.
+type GistSyncer struct {
+	// Description and Public are used when the gist is created on the first
+	// sync.
+
+	// Description 和 Public 在首次同步创建 gist 时使用.
+	Description string
+	Public      bool
+	// contains filtered or unexported fields
+}
+
+// NewGistSyncer returns a GistSyncer for dir. If state is nil or has no
+// GistID, a new gist is created on the first call to Sync.
+
+// NewGistSyncer 返回一个 dir 的 GistSyncer. 如果 state 为 nil 或没有 GistID,
+// 首次调用 Sync 时会创建新的 gist.
+func NewGistSyncer(client *Client, dir string, state *GistSyncState) *GistSyncer
+
+// Plan computes the changes needed to sync dir and the gist without
+// applying them. It returns an error if the gist is truncated.
+
+// Plan 计算同步 dir 和 gist 所需的变更, 但并不执行. 如果 gist 被截断, 返回错误.
+func (s *GistSyncer) Plan() (*GistSyncPlan, *Response, error)
+
+// State returns the state recorded by the last successful sync.
+
+// State 返回上次成功同步所记录的状态.
+func (s *GistSyncer) State() *GistSyncState
+
+// Sync computes a plan and applies every change except conflicts, sending
+// local additions, edits and removals in a single GistsService.EditFiles call
+// and writing remote changes, read with GistsService.DownloadFile, into dir.
+// It returns an error before applying anything if the gist is truncated. The
+// returned plan describes what was done.
+
+// Sync 计算变更计划并执行除冲突外的所有变更, 在单次 GistsService.EditFiles
+// 调用中发送本地的增加, 编辑和删除, 并将通过 GistsService.DownloadFile 读取的
+// 远程变更写入 dir. 如果 gist 被截断, 在执行任何变更前返回错误.
+// 返回的计划描述已执行的变更.
+func (s *GistSyncer) Sync() (*GistSyncPlan, *Response, error)
+
 // GistsService handles communication with the Gist related methods of the GitHub
 // API.
 //
@@ -1289,6 +1848,19 @@ func (s *GistsService) Delete(id string) (*Response, error)
 // GitHub API 文档: http://developer.github.com/v3/gists/comments/#delete-a-comment
 func (s *GistsService) DeleteComment(gistID string, commentID int) (*Response, error)
 
+// DownloadFile returns a reader for the complete content of a gist file. If
+// file.Content is set and the file is not truncated, the reader is served from
+// file.Content and no request is made. Otherwise, including for files returned
+// by List, ListAll and ListStarred, which carry no content, the content is
+// streamed from file.RawURL and the returned Response is non-nil. It is the
+// caller's responsibility to close the reader.
+
+// DownloadFile 返回一个读取 gist 文件完整内容的 reader. 如果 file.Content
+// 已设置且文件没有被截断, reader 直接读取 file.Content, 不发起请求. 否则,
+// 包括 List, ListAll 和 ListStarred 返回的不含内容的文件, 从 file.RawURL
+// 流式读取内容, 返回的 Response 不为 nil. 调用者负责关闭 reader.
+func (s *GistsService) DownloadFile(file *GistFile, opt *GistDownloadOptions) (io.ReadCloser, *Response, error)
+
 // Edit a gist.
 //
 // GitHub API docs: http://developer.github.com/v3/gists/#edit-a-gist
@@ -1307,6 +1879,15 @@ func (s *GistsService) Edit(id string, gist *Gist) (*Gist, *Response, error)
 // GitHub API 文档: http://developer.github.com/v3/gists/comments/#edit-a-comment
 func (s *GistsService) EditComment(gistID string, commentID int, comment *GistComment) (*GistComment, *Response, error)
 
+// EditFiles edits a gist with a GistRequest. Unlike Edit, it can delete files.
+//
+// GitHub API docs: http://developer.github.com/v3/gists/#edit-a-gist
+
+// EditFiles 以 GistRequest 编辑一个 gist. 与 Edit 不同, 它可以删除文件.
+//
+// GitHub API 文档: http://developer.github.com/v3/gists/#edit-a-gist
+func (s *GistsService) EditFiles(id string, gist *GistRequest) (*Gist, *Response, error)
+
 // Fork a gist.
 //
 // GitHub API docs: http://developer.github.com/v3/gists/#fork-a-gist
@@ -1336,6 +1917,17 @@ func (s *GistsService) Get(id string) (*Gist, *Response, error)
 // http://developer.github.com/v3/gists/comments/#get-a-single-comment
 func (s *GistsService) GetComment(gistID string, commentID int) (*GistComment, *Response, error)
 
+// GetRevision gets a specific revision of a gist.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/gists/#get-a-specific-revision-of-a-gist
+
+// GetRevision 获取某个 gist 的特定修订版本.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/gists/#get-a-specific-revision-of-a-gist
+func (s *GistsService) GetRevision(id, sha string) (*Gist, *Response, error)
+
 // IsStarred checks if a gist is starred by authenticated user.
 //
 // GitHub API docs:
@@ -1379,6 +1971,26 @@ func (s *GistsService) ListAll(opt *GistListOptions) ([]Gist, *Response, error)
 // http://developer.github.com/v3/gists/comments/#list-comments-on-a-gist
 func (s *GistsService) ListComments(gistID string, opt *ListOptions) ([]GistComment, *Response, error)
 
+// ListCommits lists commits of a gist, most recent first. The ChangeStatus
+// of each commit reports the additions, deletions and total changes it made.
+//
+// GitHub API docs: https://developer.github.com/v3/gists/#list-gist-commits
+
+// ListCommits 罗列某 gist 的提交, 最新的在前. 每个提交的 ChangeStatus
+// 报告其增加, 删除和总的变更数.
+//
+// GitHub API 文档: https://developer.github.com/v3/gists/#list-gist-commits
+func (s *GistsService) ListCommits(id string, opt *ListOptions) ([]GistCommit, *Response, error)
+
+// ListForks lists forks of a gist.
+//
+// GitHub API docs: https://developer.github.com/v3/gists/#list-gist-forks
+
+// ListForks 罗列某 gist 的分叉.
+//
+// GitHub API 文档: https://developer.github.com/v3/gists/#list-gist-forks
+func (s *GistsService) ListForks(id string, opt *ListOptions) ([]GistFork, *Response, error)
+
 // ListStarred lists starred gists of authenticated user.
 //
 // GitHub API docs: http://developer.github.com/v3/gists/#list-gists
@@ -1476,11 +2088,16 @@ func (s *GitService) CreateTag(owner string, repo string, tag *Tag) (*Tag, *Resp
 // modifying that tree are specified, it will overwrite the contents of that tree
 // with the new path contents and write a new tree out.
 //
+// An entry with neither SHA nor Content removes its Path from baseTree; see
+// TreeEntry.MarshalJSON.
+//
 // GitHub API docs: http://developer.github.com/v3/git/trees/#create-a-tree
 
 // CreateTree 在某仓库新建一个树. 如果定义的树和嵌套的路径都更改了,
 // 它会用新的路径内容写一个新树覆盖原树的内容.
 //
+// 既无 SHA 也无 Content 的条目会从 baseTree 中删除其 Path, 见 TreeEntry.MarshalJSON.
+//
 // GitHub API 文档: http://developer.github.com/v3/git/trees/#create-a-tree
 func (s *GitService) CreateTree(owner string, repo string, baseTree string, entries []TreeEntry) (*Tree, *Response, error)
 
@@ -1493,6 +2110,37 @@ func (s *GitService) CreateTree(owner string, repo string, baseTree string, entr
 // GitHub API 文档: http://developer.github.com/v3/git/refs/#delete-a-reference
 func (s *GitService) DeleteRef(owner string, repo string, ref string) (*Response, error)
 
+// DeleteRefs deletes each of refs from a repository. It does not stop at the
+// first failure: the returned slice holds one result per ref, in order.
+
+// DeleteRefs 从某仓库删除 refs 中的每个引用. 遇到失败不会停止:
+// 返回的切片按顺序为每个引用保存一个结果.
+func (s *GitService) DeleteRefs(owner, repo string, refs []string, opt *RefOperationOptions) []RefOperationResult
+
+// DiffTrees compares the trees of the base and head commits and reports every
+// added, removed, modified and renamed file. Unlike
+// RepositoriesService.CompareCommits the result is not capped: the two trees
+// are walked with GetTree, descending only into subtrees whose SHA differs.
+//
+// A file is "modified" when its blob SHA or its mode changed, so a mode-only
+// change such as chmod +x is reported with equal SHA and PreviousSHA and
+// differing Mode and PreviousMode. A removed and an added file are reported as
+// a single "renamed" change only when their blob SHA is shared by no other
+// removed file and no other added file; files whose SHA is ambiguous, such as
+// empty files or duplicated copies, stay reported as "removed" and "added".
+// Changes are sorted by path.
+
+// DiffTrees 比较 base 和 head 提交的树, 报告每个增加, 删除, 修改和重命名的文件.
+// 与 RepositoriesService.CompareCommits 不同, 结果没有数量上限:
+// 两棵树通过 GetTree 遍历, 只深入 SHA 不同的子树.
+//
+// 当文件的 blob SHA 或模式改变时, 文件为 "modified", 因此仅模式的变更 (例如
+// chmod +x) 被报告为 SHA 与 PreviousSHA 相同, 而 Mode 与 PreviousMode 不同.
+// 只有当一个删除文件和一个增加文件的 blob SHA 不被其它任何删除文件或增加文件
+// 共享时, 它们才被报告为一次 "renamed" 变更; SHA 有歧义的文件, 比如空文件或
+// 重复的副本, 仍被报告为 "removed" 和 "added". 变更以路径排序.
+func (s *GitService) DiffTrees(owner, repo, base, head string) ([]TreeChange, *Response, error)
+
 // GetBlob fetchs a blob from a repo given a SHA.
 //
 // GitHub API docs: http://developer.github.com/v3/git/blobs/#get-a-blob
@@ -1538,11 +2186,13 @@ func (s *GitService) GetTag(owner string, repo string, sha string) (*Tag, *Respo
 // GitHub API 文档: http://developer.github.com/v3/git/trees/#get-a-tree
 func (s *GitService) GetTree(owner string, repo string, sha string, recursive bool) (*Tree, *Response, error)
 
-// ListRefs lists all refs in a repository.
+// ListRefs lists all refs in a repository. If opt.Pattern is malformed,
+// path.ErrBadPattern is returned and no request is made.
 //
 // GitHub API docs: http://developer.github.com/v3/git/refs/#get-all-references
 
-// ListRefs 罗列某仓库的所有引用.
+// ListRefs 罗列某仓库的所有引用. 如果 opt.Pattern 格式错误,
+// 返回 path.ErrBadPattern, 不发起请求.
 //
 // GitHub API 文档: http://developer.github.com/v3/git/refs/#get-all-references
 func (s *GitService) ListRefs(owner, repo string, opt *ReferenceListOptions) ([]Reference, *Response, error)
@@ -1556,6 +2206,13 @@ func (s *GitService) ListRefs(owner, repo string, opt *ReferenceListOptions) ([]
 // GitHub API 文档: http://developer.github.com/v3/git/refs/#update-a-reference
 func (s *GitService) UpdateRef(owner string, repo string, ref *Reference, force bool) (*Reference, *Response, error)
 
+// UpdateRefs points each of refs to its Object.SHA. It does not stop at the
+// first failure: the returned slice holds one result per ref, in order.
+
+// UpdateRefs 将 refs 中的每个引用指向其 Object.SHA. 遇到失败不会停止:
+// 返回的切片按顺序为每个引用保存一个结果.
+func (s *GitService) UpdateRefs(owner, repo string, refs []Reference, opt *RefOperationOptions) []RefOperationResult
+
 // Gitignore represents a .gitignore file as returned by the GitHub API.
 
 // Gitignore 表示 GitHub API 所返回的一个 .gitignore 文件.
@@ -1566,6 +2223,42 @@ type Gitignore struct {
 
 func (g Gitignore) String() string
 
+// GitignoreMatcher evaluates gitignore rules, such as the Source of templates
+// returned by GitignoresService.Get. It supports comments, escaped leading
+// "#" and "!", negated rules, directory-only rules with a trailing slash,
+// rules anchored by a leading or inner slash, and "*", "?", "[...]" and "**"
+// wildcards. As in git, the last matching rule decides, and a file can not be
+// re-included if one of its parent directories is excluded.
+
+// GitignoreMatcher 执行 gitignore 规则, 例如 GitignoresService.Get 所返回模版的
+// Source. 它支持注释, 转义的前导 "#" 和 "!", 否定规则, 以斜线结尾的仅目录规则,
+// 以前导或中间斜线锚定的规则, 以及 "*", "?", "[...]" 和 "**" 通配符.
+// 与 git 一致, 最后一个匹配的规则起决定作用, 如果某文件的父目录被排除,
+// 则该文件不能被重新包含.
+type GitignoreMatcher struct {
+	// contains filtered or unexported fields
+}
+
+// NewGitignoreMatcher returns a GitignoreMatcher composed of the rules of
+// templates, in order. Rules of later templates take precedence.
+
+// NewGitignoreMatcher 返回一个按顺序由 templates 的规则组成的 GitignoreMatcher.
+// 后面模版的规则优先.
+func NewGitignoreMatcher(templates ...Gitignore) *GitignoreMatcher
+
+// AddSource appends the rules of a gitignore source text to m. Lines that are
+// not valid patterns are skipped.
+
+// AddSource 将 gitignore 源文本的规则追加到 m. 跳过不是有效模式的行.
+func (m *GitignoreMatcher) AddSource(source string)
+
+// Ignored reports whether the slash-separated path, relative to the root of
+// the repository, is ignored. isDir tells whether path names a directory.
+
+// Ignored 报告相对于仓库根目录的, 以斜线分隔的 path 是否被忽略.
+// isDir 说明 path 是否为目录.
+func (m *GitignoreMatcher) Ignored(path string, isDir bool) bool
+
 // GitignoresService provides access to the gitignore related functions in the
 // GitHub API.
 //
@@ -1611,17 +2304,43 @@ type Hook struct {
 
 func (h Hook) String() string
 
+// Invitation represents a pending invitation to join an organization.
+
+// Invitation 表示一个待处理的组织加入邀请.
+type Invitation struct {
+	ID    *int    `json:"id,omitempty"`
+	Login *string `json:"login,omitempty"`
+	Email *string `json:"email,omitempty"`
+
+	// Role can be one of the values: admin, direct_member, billing_manager,
+	// hiring_manager or reinstate.
+
+	// Role 可以是以下值之一: admin, direct_member, billing_manager,
+	// hiring_manager 或 reinstate.
+	Role *string `json:"role,omitempty"`
+
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	Inviter           *User      `json:"inviter,omitempty"`
+	TeamCount         *int       `json:"team_count,omitempty"`
+	InvitationTeamURL *string    `json:"invitation_team_url,omitempty"`
+}
+
+func (i Invitation) String() string
+
 // Issue represents a GitHub issue on a repository.
 
 // Issue 表示某仓库的一个 GitHub 问题.
 type Issue struct {
 	Number           *int              `json:"number,omitempty"`
 	State            *string           `json:"state,omitempty"`
+	Locked           *bool             `json:"locked,omitempty"`
+	ActiveLockReason *string           `json:"active_lock_reason,omitempty"`
 	Title            *string           `json:"title,omitempty"`
 	Body             *string           `json:"body,omitempty"`
 	User             *User             `json:"user,omitempty"`
 	Labels           []Label           `json:"labels,omitempty"`
 	Assignee         *User             `json:"assignee,omitempty"`
+	Assignees        []User            `json:"assignees,omitempty"`
 	Comments         *int              `json:"comments,omitempty"`
 	ClosedAt         *time.Time        `json:"closed_at,omitempty"`
 	CreatedAt        *time.Time        `json:"created_at,omitempty"`
@@ -1630,6 +2349,7 @@ type Issue struct {
 	HTMLURL          *string           `json:"html_url,omitempty"`
 	Milestone        *Milestone        `json:"milestone,omitempty"`
 	PullRequestLinks *PullRequestLinks `json:"pull_request,omitempty"`
+	Reactions        *Reactions        `json:"reactions,omitempty"`
 
 	// TextMatches is only populated from search results that request text matches
 	// See: search.go and https://developer.github.com/v3/search/#text-match-metadata
@@ -1663,6 +2383,7 @@ type IssueComment struct {
 	URL       *string    `json:"url,omitempty"`
 	HTMLURL   *string    `json:"html_url,omitempty"`
 	IssueURL  *string    `json:"issue_url,omitempty"`
+	Reactions *Reactions `json:"reactions,omitempty"`
 }
 
 func (i IssueComment) String() string
@@ -1898,20 +2619,67 @@ type IssueListOptions struct {
 }
 
 // IssueRequest represents a request to create/edit an issue. It is separate from
-// Issue above because otherwise Labels and Assignee fail to serialize to the
-// correct JSON.
+// Issue above because otherwise Labels, Assignee and Assignees fail to serialize
+// to the correct JSON.
 
 // IssueRequest 表示建立/编辑一个问题的请求. 它在 Issue 上是独立的,
-// 不然的话 Labels 和 Assignee 不能序列化到正确的 JSON.
+// 不然的话 Labels, Assignee 和 Assignees 不能序列化到正确的 JSON.
 type IssueRequest struct {
 	Title     *string  `json:"title,omitempty"`
 	Body      *string  `json:"body,omitempty"`
 	Labels    []string `json:"labels,omitempty"`
 	Assignee  *string  `json:"assignee,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
 	State     *string  `json:"state,omitempty"`
 	Milestone *int     `json:"milestone,omitempty"`
 }
 
+// IssueTemplate represents an issue or pull request template of a repository.
+// The optional YAML front matter of the template file is decoded into Name,
+// About, Title, Labels and Assignees; Labels and Assignees may be given either
+// as a list or as a comma separated string.
+
+// IssueTemplate 表示某仓库的问题或上拉请求模版. 模版文件中可选的 YAML
+// front matter 被解码到 Name, About, Title, Labels 和 Assignees;
+// Labels 和 Assignees 既可以是列表, 也可以是以逗号分隔的字符串.
+type IssueTemplate struct {
+	// Path is the path of the template file in the repository.
+
+	// Path 为模版文件在仓库中的路径.
+	Path *string `json:"path,omitempty"`
+
+	// Name defaults to the file name without extension when the front
+	// matter has none.
+
+	// 如果 front matter 中没有 Name, 缺省为不含扩展名的文件名.
+	Name      *string  `json:"name,omitempty"`
+	About     *string  `json:"about,omitempty"`
+	Title     *string  `json:"title,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+
+	// Body is the content of the template, without the front matter.
+
+	// Body 为模版的内容, 不含 front matter.
+	Body *string `json:"body,omitempty"`
+}
+
+// IssueRequest returns an IssueRequest pre-filled with the default title,
+// body, labels and assignees of t.
+
+// IssueRequest 返回一个以 t 的默认标题, 内容, 标记和受理人预先填充的
+// IssueRequest.
+func (t *IssueTemplate) IssueRequest() *IssueRequest
+
+// NewPullRequest returns a NewPullRequest from head to base pre-filled with
+// the default title and body of t.
+
+// NewPullRequest 返回一个从 head 到 base, 以 t 的默认标题和内容预先填充的
+// NewPullRequest.
+func (t *IssueTemplate) NewPullRequest(head, base string) *NewPullRequest
+
+func (t IssueTemplate) String() string
+
 // IssuesSearchResult represents the result of an issues search.
 
 // IssuesSearchResult 表示问题搜索的结果.
@@ -1932,6 +2700,20 @@ type IssuesService struct {
 	// contains filtered or unexported fields
 }
 
+// AddAssignees adds the provided GitHub users as assignees to the issue. Each
+// user is first checked with IsAssignee, and no request is made if any of
+// them can not be assigned in the repository.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/issues/assignees/#add-assignees-to-an-issue
+
+// AddAssignees 将所提供的 GitHub 用户添加为该问题的受理人. 每个用户先经过
+// IsAssignee 检查, 如果其中任何用户不能在该仓库中被指派, 则不发起请求.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/issues/assignees/#add-assignees-to-an-issue
+func (s *IssuesService) AddAssignees(owner string, repo string, number int, assignees []string) (*Issue, *Response, error)
+
 // AddLabelsToIssue adds labels to an issue.
 //
 // GitHub API docs:
@@ -2180,6 +2962,19 @@ func (s *IssuesService) ListComments(owner string, repo string, number int, opt
 // https://developer.github.com/v3/issues/events/#list-events-for-an-issue
 func (s *IssuesService) ListIssueEvents(owner, repo string, number int, opt *ListOptions) ([]IssueEvent, *Response, error)
 
+// ListIssueTimeline lists the events, commits, comments and reviews of the
+// specified issue or pull request as a single stream, in chronological order.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/issues/timeline/#list-events-for-an-issue
+
+// ListIssueTimeline 以单一流的形式, 按时间顺序罗列指定问题或上拉请求的事件,
+// 提交, 评论和审查.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/issues/timeline/#list-events-for-an-issue
+func (s *IssuesService) ListIssueTimeline(owner, repo string, number int, opt *ListOptions) ([]Timeline, *Response, error)
+
 // ListLabels lists all labels for a repository.
 //
 // GitHub API docs:
@@ -2235,6 +3030,29 @@ func (s *IssuesService) ListMilestones(owner string, repo string, opt *Milestone
 // https://developer.github.com/v3/issues/events/#list-events-for-a-repository
 func (s *IssuesService) ListRepositoryEvents(owner, repo string, opt *ListOptions) ([]IssueEvent, *Response, error)
 
+// Lock an issue's conversation. Only users with push access can lock or unlock
+// a conversation, and it can not be locked again while it is locked.
+//
+// GitHub API docs: https://developer.github.com/v3/issues/#lock-an-issue
+
+// Lock 锁定某问题的会话. 只有具有 push 权限的用户才能锁定或解锁会话,
+// 已锁定的会话不能再次锁定.
+//
+// GitHub API 文档: https://developer.github.com/v3/issues/#lock-an-issue
+func (s *IssuesService) Lock(owner string, repo string, number int, opt *LockIssueOptions) (*Response, error)
+
+// RemoveAssignees removes the provided GitHub users as assignees from the
+// issue.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/issues/assignees/#remove-assignees-from-an-issue
+
+// RemoveAssignees 从该问题的受理人中删除所提供的 GitHub 用户.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/issues/assignees/#remove-assignees-from-an-issue
+func (s *IssuesService) RemoveAssignees(owner string, repo string, number int, assignees []string) (*Issue, *Response, error)
+
 // RemoveLabelForIssue removes a label for an issue.
 //
 // GitHub API docs:
@@ -2268,6 +3086,15 @@ func (s *IssuesService) RemoveLabelsForIssue(owner string, repo string, number i
 // http://developer.github.com/v3/issues/labels/#replace-all-labels-for-an-issue
 func (s *IssuesService) ReplaceLabelsForIssue(owner string, repo string, number int, labels []string) ([]Label, *Response, error)
 
+// Unlock an issue's conversation.
+//
+// GitHub API docs: https://developer.github.com/v3/issues/#unlock-an-issue
+
+// Unlock 解锁某问题的会话.
+//
+// GitHub API 文档: https://developer.github.com/v3/issues/#unlock-an-issue
+func (s *IssuesService) Unlock(owner string, repo string, number int) (*Response, error)
+
 // Key represents a public SSH key used to authenticate a user or deploy script.
 
 // Key 表示公共 SSH 授权密钥, 用于用户或部署脚本.
@@ -2284,43 +3111,249 @@ func (k Key) String() string
 
 // Label 表示某问题的 GitHub 标记.
 type Label struct {
-	URL   *string `json:"url,omitempty"`
-	Name  *string `json:"name,omitempty"`
-	Color *string `json:"color,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 func (l Label) String() string
 
-// ListContributorsOptions specifies the optional parameters to the
-// RepositoriesService.ListContributors method.
+// LabelChange describes a single change of a LabelPlan.
 
-// ListContributorsOptions 指定 RepositoriesService.ListContributors 方法的可选参数.
-type ListContributorsOptions struct {
-	// Include anonymous contributors in results or not
+// LabelChange 描述 LabelPlan 中的单个变更.
+type LabelChange struct {
+	// Action is one of "create", "update", "rename" or "delete". A rename may
+	// also update the color and description of the label.
 
-	// 结果是否包含匿名贡献者
-	Anon string `url:"anon,omitempty"`
+	// Action 为 "create", "update", "rename" 或 "delete" 之一.
+	// 重命名时也可能同时更新标记的颜色和描述.
+	Action string
 
-	ListOptions
-}
+	// Name is the current name of the label, or the new name for "create".
 
-// ListMembersOptions specifies optional parameters to the
-// OrganizationsService.ListMembers method.
+	// Name 为标记的当前名称, 对于 "create" 为新名称.
+	Name string
 
-// ListMembersOptions 指定 OrganizationsService.ListMembers 方法的可选参数.
-type ListMembersOptions struct {
-	// If true (or if the authenticated user is not an owner of the
-	// organization), list only publicly visible members.
+	// Label holds the desired state. It is nil for "delete".
 
-	// 如果为 true (或者授权用户不是组织的拥有者), 仅罗列公共可见成员.
-	PublicOnly bool `url:"-"`
+	// Label 为期望的状态. 对于 "delete" 它为 nil.
+	Label *Label
 
-	// Filter members returned in the list.  Possible values are:
-	// 2fa_disabled, all.  Default is "all".
+	// Err is set by LabelSyncer.Apply if the change failed.
+
+	// 如果变更失败, LabelSyncer.Apply 设置 Err.
+	Err error
+}
+
+// LabelDefinition describes a label that should exist in a repository.
+
+// LabelDefinition 描述某仓库中应存在的标记.
+type LabelDefinition struct {
+	Name        string
+	Color       string
+	Description string
+
+	// Aliases are former names of the label. An existing label matching an
+	// alias is renamed rather than recreated, so that the issues it is
+	// applied to keep it.
+	//
+	// A rename is only planned when no label named Name exists yet, since
+	// EditLabel can not rename onto an existing name. If Name already exists,
+	// it gets an "update" when needed and the alias labels are kept as they
+	// are; with LabelSyncer.Prune set, they are deleted instead. If several
+	// aliases exist, only the first in Aliases order is renamed and the other
+	// ones are handled the same way.
+
+	// Aliases 为标记以前的名称. 与某别名匹配的现有标记会被重命名, 而不是重新创建,
+	// 以便使用该标记的问题继续保留它.
+	//
+	// 只有在名为 Name 的标记尚不存在时才计划重命名, 因为 EditLabel 不能重命名为
+	// 已存在的名称. 如果 Name 已存在, 必要时对其计划 "update", 别名标记保持原样;
+	// 如果设置了 LabelSyncer.Prune, 则删除它们. 如果存在多个别名,
+	// 只重命名 Aliases 中的第一个, 其余的按同样方式处理.
+	Aliases []string
+}
+
+// LabelPlan is the set of changes that brings the labels of a repository in
+// line with a set of LabelDefinition.
+
+// LabelPlan 为使某仓库标记与一组 LabelDefinition 相符的变更集合.
+type LabelPlan struct {
+	Owner   string
+	Repo    string
+	Changes []LabelChange
+}
+
+// WriteTo writes a human readable summary of p to w, one change per line,
+// followed by the error of the change if any. It implements io.WriterTo.
+
+// WriteTo 将 p 的可读摘要写入 w, 每行一个变更, 如果有错误则跟随变更的错误.
+// 它实现了 io.WriterTo.
+func (p *LabelPlan) WriteTo(w io.Writer) (int64, error)
+
+// LabelSyncer reconciles the labels of repositories with a desired set of
+// LabelDefinition, using IssuesService.ListLabels, CreateLabel, EditLabel
+// and DeleteLabel. Label names are compared case-insensitively, as GitHub
+// does.
+
+// LabelSyncer 使用 IssuesService.ListLabels, CreateLabel, EditLabel 和
+// DeleteLabel, 将仓库的标记与期望的一组 LabelDefinition 进行协调.
+// 与 GitHub 一致, 标记名称比较时不区分大小写.
+type LabelSyncer struct {
+	// Prune deletes the labels that match no definition. By default they are
+	// left alone.
+
+	// Prune 删除不匹配任何定义的标记. 缺省保留它们.
+	Prune bool
+	// contains filtered or unexported fields
+}
+
+// NewLabelSyncer returns a LabelSyncer for the given definitions.
+
+// NewLabelSyncer 返回给定定义的 LabelSyncer.
+func NewLabelSyncer(client *Client, labels []LabelDefinition) *LabelSyncer
+
+// Apply performs the changes of plan in order. It does not stop at the first
+// failure; the Err field of each failed change is set and the number of
+// failed changes is returned.
+
+// Apply 依次执行 plan 中的变更. 遇到失败不会停止; 每个失败变更的 Err 字段
+// 会被设置, 并返回失败变更的数量.
+func (s *LabelSyncer) Apply(plan *LabelPlan) int
+
+// Plan computes the changes needed for the owner/repo repository without
+// applying them. It can be used as a dry run.
+
+// Plan 计算 owner/repo 仓库所需的变更, 但并不执行. 它可用作 dry run.
+func (s *LabelSyncer) Plan(owner, repo string) (*LabelPlan, *Response, error)
+
+// License represents an open source license.
+
+// License 表示一个开源许可证.
+type License struct {
+	Key  *string `json:"key,omitempty"`
+	Name *string `json:"name,omitempty"`
+	URL  *string `json:"url,omitempty"`
+
+	HTMLURL        *string `json:"html_url,omitempty"`
+	Featured       *bool   `json:"featured,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Implementation *string `json:"implementation,omitempty"`
+
+	// Permissions, Conditions and Limitations list the keys of what the
+	// license allows, requires and forbids, e.g. "commercial-use",
+	// "include-copyright" or "no-liability".
+
+	// Permissions, Conditions 和 Limitations 罗列许可证允许, 要求和禁止事项的键,
+	// 例如 "commercial-use", "include-copyright" 或 "no-liability".
+	Permissions []string `json:"permissions,omitempty"`
+	Conditions  []string `json:"conditions,omitempty"`
+	Limitations []string `json:"limitations,omitempty"`
+
+	// Body is the text of the license template.
+
+	// Body 为许可证模版的文本.
+	Body *string `json:"body,omitempty"`
+}
+
+func (l License) String() string
+
+// LicensesService handles communication with the license related methods of
+// the GitHub API.
+//
+// GitHub API docs: https://developer.github.com/v3/licenses/
+
+// LicensesService 处理与许可证相关的 GitHub API 通信方法.
+//
+// GitHub API 文档: https://developer.github.com/v3/licenses/
+type LicensesService struct {
+	// contains filtered or unexported fields
+}
+
+// Get extended metadata for one license, including its Body.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/licenses/#get-an-individual-license
+
+// Get 获取某个许可证的扩展元数据, 包括其 Body.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/licenses/#get-an-individual-license
+func (s *LicensesService) Get(licenseName string) (*License, *Response, error)
+
+// List popular open source licenses. Only Key, Name and URL are populated.
+//
+// GitHub API docs: https://developer.github.com/v3/licenses/#list-all-licenses
+
+// List 罗列流行的开源许可证. 只填充 Key, Name 和 URL.
+//
+// GitHub API 文档: https://developer.github.com/v3/licenses/#list-all-licenses
+func (s *LicensesService) List() ([]License, *Response, error)
+
+// ListCollaboratorsOptions specifies the optional parameters to the
+// RepositoriesService.ListCollaborators method.
+
+// ListCollaboratorsOptions 指定 RepositoriesService.ListCollaborators
+// 方法的可选参数.
+type ListCollaboratorsOptions struct {
+	// Affiliation specifies how collaborators should be filtered by their
+	// affiliation. Possible values are:
+	//     outside - all outside collaborators of an organization-owned repository
+	//     direct - all collaborators with permissions to an organization-owned
+	//              repository, regardless of organization membership status
+	//     all - all collaborators the authenticated user can see
+	//
+	// Default value is "all".
+
+	// Affiliation 指定如何按隶属关系过滤协作者. 可能的值有:
+	//     outside - 组织所拥有仓库的所有外部协作者
+	//     direct - 对组织所拥有仓库直接具有权限的所有协作者, 不论其组织成员状态
+	//     all - 授权用户可见的所有协作者
+	//
+	// 缺省值为 "all".
+	Affiliation string `url:"affiliation,omitempty"`
+
+	ListOptions
+}
+
+// ListContributorsOptions specifies the optional parameters to the
+// RepositoriesService.ListContributors method.
+
+// ListContributorsOptions 指定 RepositoriesService.ListContributors 方法的可选参数.
+type ListContributorsOptions struct {
+	// Include anonymous contributors in results or not
+
+	// 结果是否包含匿名贡献者
+	Anon string `url:"anon,omitempty"`
+
+	ListOptions
+}
+
+// ListMembersOptions specifies optional parameters to the
+// OrganizationsService.ListMembers method.
+
+// ListMembersOptions 指定 OrganizationsService.ListMembers 方法的可选参数.
+type ListMembersOptions struct {
+	// If true (or if the authenticated user is not an owner of the
+	// organization), list only publicly visible members.
+
+	// 如果为 true (或者授权用户不是组织的拥有者), 仅罗列公共可见成员.
+	PublicOnly bool `url:"-"`
+
+	// Filter members returned in the list.  Possible values are:
+	// 2fa_disabled, all.  Default is "all".
 
 	// 过滤返回成员列表. 可能的值为: 2fa_disabled, all.  缺省为 "all".
 	Filter string `url:"filter,omitempty"`
 
+	// Role filters members returned by their role in the organization.
+	// Possible values are: all, admin, member. Default is "all".
+
+	// Role 以组织中的角色过滤返回的成员. 可能的值为: all, admin, member.
+	// 缺省为 "all".
+	Role string `url:"role,omitempty"`
+
 	ListOptions
 }
 
@@ -2354,6 +3387,35 @@ type ListOrgMembershipsOptions struct {
 	ListOptions
 }
 
+// ListOutsideCollaboratorsOptions specifies optional parameters to the
+// OrganizationsService.ListOutsideCollaborators method.
+
+// ListOutsideCollaboratorsOptions 指定
+// OrganizationsService.ListOutsideCollaborators 方法的可选参数.
+type ListOutsideCollaboratorsOptions struct {
+	// Filter outside collaborators returned in the list. Possible values are:
+	// 2fa_disabled, all. Default is "all".
+
+	// 过滤列表中返回的外部协作者. 可能的值有: 2fa_disabled, all. 缺省为 "all".
+	Filter string `url:"filter,omitempty"`
+
+	ListOptions
+}
+
+// LockIssueOptions specifies the optional parameters to the IssuesService.Lock
+// method.
+
+// LockIssueOptions 指定 IssuesService.Lock 方法的可选参数.
+type LockIssueOptions struct {
+	// LockReason specifies the reason to lock this issue. Possible values
+	// are: "off-topic", "too heated", "resolved", "spam". It is reported back
+	// in the ActiveLockReason of the issue.
+
+	// LockReason 指定锁定该问题的原因. 可能的值有: "off-topic", "too heated",
+	// "resolved", "spam". 它会在问题的 ActiveLockReason 中返回.
+	LockReason string `json:"lock_reason,omitempty"`
+}
+
 // MarkdownOptions specifies optional parameters to the Markdown method.
 
 // MarkdownOptions 为 Markdown 方法指定可选参数.
@@ -2439,11 +3501,34 @@ type Milestone struct {
 	ClosedIssues *int       `json:"closed_issues,omitempty"`
 	CreatedAt    *time.Time `json:"created_at,omitempty"`
 	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
 	DueOn        *time.Time `json:"due_on,omitempty"`
 }
 
 func (m Milestone) String() string
 
+// MilestoneBurndown is the daily time series of open and closed issues of a
+// milestone. The series starts on the day the milestone was created. It ends
+// on the day of ClosedAt for a closed milestone, and today for an open one.
+// Every point is observed data: the series is never extended to DueOn, and
+// projecting it to the due date is left to the caller.
+
+// MilestoneBurndown 为某里程碑打开和关闭问题数的每日时间序列.
+// 序列从里程碑创建当天开始. 已关闭的里程碑结束于 ClosedAt 当天,
+// 未关闭的里程碑结束于今天. 每个点都是观测到的数据: 序列从不延长至 DueOn,
+// 将其推算至截止日期由调用者负责.
+type MilestoneBurndown struct {
+	Milestone *Milestone
+	Points    []BurndownPoint
+}
+
+// WriteCSV writes b to w as CSV with a "date,open,closed" header row. Dates
+// are formatted as YYYY-MM-DD.
+
+// WriteCSV 将 b 以 CSV 格式写入 w, 含 "date,open,closed" 标题行.
+// 日期格式为 YYYY-MM-DD.
+func (b *MilestoneBurndown) WriteCSV(w io.Writer) error
+
 // MilestoneListOptions specifies the optional parameters to the
 // IssuesService.ListMilestones method.
 
@@ -2468,6 +3553,40 @@ type MilestoneListOptions struct {
 	Direction string `url:"direction,omitempty"`
 }
 
+// MilestoneReporter reconstructs the burndown of milestones. The issues of a
+// milestone are listed with IssuesService.ListByRepo, whatever their state,
+// and their history is replayed from the closed and reopened events returned
+// by IssuesService.ListIssueEvents.
+
+// MilestoneReporter 重建里程碑的燃尽图. 使用 IssuesService.ListByRepo
+// 罗列里程碑中所有状态的问题, 并根据 IssuesService.ListIssueEvents
+// 返回的 closed 和 reopened 事件重放它们的历史.
+type MilestoneReporter struct {
+	// Location defines the day boundaries of the time series. Default is
+	// time.UTC.
+
+	// Location 定义时间序列中日期的边界. 缺省为 time.UTC.
+	Location *time.Location
+	// contains filtered or unexported fields
+}
+
+// NewMilestoneReporter returns a MilestoneReporter that uses client.
+
+// NewMilestoneReporter 返回使用 client 的 MilestoneReporter.
+func NewMilestoneReporter(client *Client) *MilestoneReporter
+
+// Burndown reconstructs the burndown of a single milestone of the owner/repo
+// repository.
+
+// Burndown 重建 owner/repo 仓库中单个里程碑的燃尽图.
+func (r *MilestoneReporter) Burndown(owner, repo string, milestone *Milestone) (*MilestoneBurndown, *Response, error)
+
+// Report reconstructs the burndown of every milestone of the owner/repo
+// repository selected by opt.
+
+// Report 重建 owner/repo 仓库中由 opt 选定的每个里程碑的燃尽图.
+func (r *MilestoneReporter) Report(owner, repo string, opt *MilestoneListOptions) ([]MilestoneBurndown, *Response, error)
+
 // NewPullRequest represents a new pull request to be created.
 
 // NewPullRequest 表示新建立一个上拉请求.
@@ -2522,6 +3641,126 @@ type NotificationSubject struct {
 	Type             *string `json:"type,omitempty"`
 }
 
+// OrgChange describes a single change of an OrgPlan.
+
+// OrgChange 描述 OrgPlan 中的单个变更.
+type OrgChange struct {
+	// Action is one of "create-team", "edit-team", "add-member",
+	// "remove-member", "set-role", "add-repo" and "remove-repo".
+
+	// Action 为 "create-team", "edit-team", "add-member", "remove-member",
+	// "set-role", "add-repo" 和 "remove-repo" 之一.
+	Action string
+
+	// Team is empty for organization level changes.
+
+	// 对于组织级别的变更, Team 为空.
+	Team string
+
+	User string
+	Repo string
+
+	// Role is the organization or team role for member changes, and
+	// Permission the permission for repository grants.
+
+	// Role 为成员变更时的组织或团队角色, Permission 为仓库授权的权限.
+	Role       string
+	Permission string
+
+	// Err is set by OrgReconciler.Apply if the change failed.
+
+	// 如果变更失败, OrgReconciler.Apply 设置 Err.
+	Err error
+}
+
+// OrgConfig describes the desired teams and memberships of an organization.
+
+// OrgConfig 描述某组织期望的团队和成员资格.
+type OrgConfig struct {
+	// Admins and Members list the logins of the organization owners and
+	// members. Logins listed in a team are implicitly members.
+
+	// Admins 和 Members 罗列组织拥有者和成员的登录名.
+	// 团队中所列的登录名隐含为成员.
+	Admins  []string `json:"admins,omitempty"`
+	Members []string `json:"members,omitempty"`
+
+	Teams []TeamConfig `json:"teams,omitempty"`
+}
+
+// ParseOrgConfig decodes an OrgConfig from the YAML document read from r.
+// Since JSON is a subset of YAML, JSON documents are accepted too.
+
+// ParseOrgConfig 从 r 读取的 YAML 文档中解码 OrgConfig.
+// 因为 JSON 是 YAML 的子集, 也接受 JSON 文档.
+func ParseOrgConfig(r io.Reader) (*OrgConfig, error)
+
+// OrgPlan is the set of changes that brings an organization in line with an
+// OrgConfig.
+
+// OrgPlan 为使某组织与 OrgConfig 相符的变更集合.
+type OrgPlan struct {
+	Org     string
+	Changes []OrgChange
+}
+
+// WriteTo writes a human readable summary of p to w, one change per line,
+// followed by the error of the change if any. It implements io.WriterTo.
+
+// WriteTo 将 p 的可读摘要写入 w, 每行一个变更, 如果有错误则跟随变更的错误.
+// 它实现了 io.WriterTo.
+func (p *OrgPlan) WriteTo(w io.Writer) (int64, error)
+
+// OrgReconciler reconciles the teams, team members, maintainers, repository
+// grants and member roles of an organization with an OrgConfig, using
+// OrganizationsService.CreateTeam, EditTeam, AddTeamMembership,
+// RemoveTeamMembership, AddTeamRepo, RemoveTeamRepo and EditOrgMembership.
+// Parent teams are created before their children.
+
+// OrgReconciler 使用 OrganizationsService.CreateTeam, EditTeam,
+// AddTeamMembership, RemoveTeamMembership, AddTeamRepo, RemoveTeamRepo 和
+// EditOrgMembership, 将某组织的团队, 团队成员, 维护者, 仓库授权和成员角色
+// 与 OrgConfig 进行协调. 父团队先于其子团队创建.
+type OrgReconciler struct {
+	// Prune removes the team members, with RemoveTeamMembership, and the
+	// repository grants, with RemoveTeamRepo, that are not in the
+	// configuration. Teams and organization members are never deleted.
+
+	// Prune 删除不在配置中的团队成员 (通过 RemoveTeamMembership) 和仓库授权
+	// (通过 RemoveTeamRepo). 团队和组织成员永远不会被删除.
+	Prune bool
+	// contains filtered or unexported fields
+}
+
+// NewOrgReconciler returns an OrgReconciler for org.
+
+// NewOrgReconciler 返回 org 的 OrgReconciler.
+func NewOrgReconciler(client *Client, org string, config *OrgConfig) *OrgReconciler
+
+// Apply performs the changes of plan in order. It does not stop at the first
+// failure; the Err field of each failed change is set and the number of
+// failed changes is returned. Changes on a team whose creation failed are
+// skipped and marked as failed.
+
+// Apply 依次执行 plan 中的变更. 遇到失败不会停止; 每个失败变更的 Err 字段
+// 会被设置, 并返回失败变更的数量. 创建失败的团队上的变更会被跳过并标记为失败.
+func (r *OrgReconciler) Apply(plan *OrgPlan) int
+
+// Plan computes the changes needed for the organization without applying
+// them. It can be used as a dry run.
+//
+// The current state is read with OrganizationsService.ListMembers and
+// GetOrgMembership for the role of each member, ListTeams, ListTeamMembers
+// once with the "maintainer" role and once with the "member" role, and
+// ListTeamRepos. Plan makes no write calls.
+
+// Plan 计算该组织所需的变更, 但并不执行. 它可用作 dry run.
+//
+// 当前状态通过 OrganizationsService.ListMembers, 获取每个成员角色的
+// GetOrgMembership, ListTeams, 分别以 "maintainer" 和 "member" 角色调用的
+// ListTeamMembers, 以及 ListTeamRepos 读取. Plan 不进行任何写调用.
+func (r *OrgReconciler) Plan() (*OrgPlan, *Response, error)
+
 // Organization represents a GitHub organization account.
 
 // Organization 表示一个 GitHub 组织账户.
@@ -2560,6 +3799,69 @@ type Organization struct {
 
 func (o Organization) String() string
 
+// OrganizationAddTeamMembershipOptions specifies the optional parameters to
+// the OrganizationsService.AddTeamMembership method.
+
+// OrganizationAddTeamMembershipOptions 指定
+// OrganizationsService.AddTeamMembership 方法的可选参数.
+type OrganizationAddTeamMembershipOptions struct {
+	// Role specifies the role the user should have in the team. Possible
+	// values are:
+	//     member - a normal member of the team
+	//     maintainer - a team maintainer. Able to add/remove other team
+	//                  members, promote other team members to team
+	//                  maintainer, and edit the team's name and description
+	//
+	// Default value is "member".
+
+	// Role 指定用户在团队中的角色. 可能的值有:
+	//     member - 团队普通成员
+	//     maintainer - 团队维护者. 能够添加/删除其他团队成员,
+	//                  将其他团队成员提升为维护者, 以及编辑团队名称和描述
+	//
+	// 缺省值为 "member".
+	Role string `json:"role,omitempty"`
+}
+
+// OrganizationAddTeamRepoOptions specifies the optional parameters to the
+// OrganizationsService.AddTeamRepo method.
+
+// OrganizationAddTeamRepoOptions 指定 OrganizationsService.AddTeamRepo
+// 方法的可选参数.
+type OrganizationAddTeamRepoOptions struct {
+	// Permission specifies the permission to grant the team on this repository.
+	// Possible values are:
+	//     pull - team members can pull, but not push to or administer this repository
+	//     push - team members can pull and push, but not administer this repository
+	//     admin - team members can pull, push and administer this repository
+	//
+	// If not specified, the team's Permission attribute will be used.
+
+	// Permission 指定在该仓库上授予团队的权限. 可能的值有:
+	//     pull - 团队成员可以 pull, 但不能 push 或管理该仓库
+	//     push - 团队成员可以 pull 和 push, 但不能管理该仓库
+	//     admin - 团队成员可以 pull, push 和管理该仓库
+	//
+	// 如果未指定, 使用团队的 Permission 属性.
+	Permission string `json:"permission,omitempty"`
+}
+
+// OrganizationListTeamMembersOptions specifies the optional parameters to the
+// OrganizationsService.ListTeamMembers method.
+
+// OrganizationListTeamMembersOptions 指定 OrganizationsService.ListTeamMembers
+// 方法的可选参数.
+type OrganizationListTeamMembersOptions struct {
+	// Role filters members returned by their role in the team. Possible
+	// values are: all, member, maintainer. Default is "all".
+
+	// Role 以团队中的角色过滤返回的成员. 可能的值为: all, member, maintainer.
+	// 缺省为 "all".
+	Role string `url:"role,omitempty"`
+
+	ListOptions
+}
+
 // OrganizationsService provides access to the organization related functions in
 // the GitHub API.
 //
@@ -2598,6 +3900,8 @@ func (s *OrganizationsService) AddTeamMember(team int, user string) (*Response,
 // membership will transition to the "active" state and the user will be added as a
 // member of the team.
 //
+// If opt is nil, the user is added with the "member" role.
+//
 // GitHub API docs: https://developer.github.com/v3/orgs/teams/#add-team-membership
 
 // AddTeamMembership 添加或邀请一个用户到团队.
@@ -2610,20 +3914,35 @@ func (s *OrganizationsService) AddTeamMember(team int, user string) (*Response,
 // 如果用户完全不属于组织团队(也就是说他不在组织的团队中), 这将给用户发送邀请邮件.
 // 新成员为 "pending" 状态, 直到该用户接受邀请, 此时用户添加到团队成员并转为 "active" 状态.
 //
+// 如果 opt 为 nil, 用户以 "member" 角色加入.
+//
 // GitHub API 文档: https://developer.github.com/v3/orgs/teams/#add-team-membership
-func (s *OrganizationsService) AddTeamMembership(team int, user string) (*Membership, *Response, error)
+func (s *OrganizationsService) AddTeamMembership(team int, user string, opt *OrganizationAddTeamMembershipOptions) (*Membership, *Response, error)
 
 // AddTeamRepo adds a repository to be managed by the specified team. The specified
 // repository must be owned by the organization to which the team belongs, or a
-// direct fork of a repository owned by the organization.
+// direct fork of a repository owned by the organization. If opt is nil or its
+// Permission is empty, the team is granted its own Permission attribute.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/teams/#add-team-repo
 
 // AddTeamRepo 添加一个仓库给指定的团队管理. 指定的仓库必须由该团队所属的组织所拥有,
-// 或者组织拥有直接 fork 的仓库.
+// 或者组织拥有直接 fork 的仓库. 如果 opt 为 nil 或其 Permission 为空,
+// 团队被授予其自身的 Permission 属性.
 //
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#add-team-repo
-func (s *OrganizationsService) AddTeamRepo(team int, owner string, repo string) (*Response, error)
+func (s *OrganizationsService) AddTeamRepo(team int, owner string, repo string, opt *OrganizationAddTeamRepoOptions) (*Response, error)
+
+// CancelInvitation cancels an organization invitation.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#cancel-an-organization-invitation
+
+// CancelInvitation 取消一个组织邀请.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#cancel-an-organization-invitation
+func (s *OrganizationsService) CancelInvitation(org string, invitationID int) (*Response, error)
 
 // ConcealMembership conceals a user's membership in an organization.
 //
@@ -2636,6 +3955,43 @@ func (s *OrganizationsService) AddTeamRepo(team int, owner string, repo string)
 // http://developer.github.com/v3/orgs/members/#conceal-a-users-membership
 func (s *OrganizationsService) ConcealMembership(org, user string) (*Response, error)
 
+// ConvertMemberToOutsideCollaborator reduces the permission level of a member
+// of the organization to that of an outside collaborator. The user keeps
+// access only to the repositories their current team membership allows.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/outside_collaborators/#convert-member-to-outside-collaborator
+
+// ConvertMemberToOutsideCollaborator 将组织成员的权限级别降为外部协作者.
+// 用户只保留其当前团队成员身份所允许访问的仓库.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/outside_collaborators/#convert-member-to-outside-collaborator
+func (s *OrganizationsService) ConvertMemberToOutsideCollaborator(org string, user string) (*Response, error)
+
+// CreateHook creates a Hook for the specified org. Name and Config are
+// required fields; Name must be "web" for organization hooks.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#create-a-hook
+
+// CreateHook 为指定组织创建一个 Hook. Name 和 Config 为必需字段;
+// 对于组织钩子, Name 必须为 "web".
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#create-a-hook
+func (s *OrganizationsService) CreateHook(org string, hook *Hook) (*Hook, *Response, error)
+
+// CreateOrgInvitation invites people to an organization by using their GitHub
+// user ID or their email address.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#create-organization-invitation
+
+// CreateOrgInvitation 通过 GitHub 用户 ID 或电子邮件地址邀请人员加入组织.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#create-organization-invitation
+func (s *OrganizationsService) CreateOrgInvitation(org string, opt *CreateOrgInvitationOptions) (*Invitation, *Response, error)
+
 // CreateTeam creates a new team within an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/teams/#create-team
@@ -2645,6 +4001,39 @@ func (s *OrganizationsService) ConcealMembership(org, user string) (*Response, e
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#create-team
 func (s *OrganizationsService) CreateTeam(org string, team *Team) (*Team, *Response, error)
 
+// CreateTeamDiscussion creates a new discussion post on a team's page. Title
+// and Body are required; set Private to restrict it to team members.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussions/#create-a-discussion
+
+// CreateTeamDiscussion 在团队页面上创建新的讨论帖. Title 和 Body 为必需字段;
+// 设置 Private 将其限定于团队成员.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussions/#create-a-discussion
+func (s *OrganizationsService) CreateTeamDiscussion(team int, discussion *TeamDiscussion) (*TeamDiscussion, *Response, error)
+
+// CreateTeamDiscussionComment creates a new comment on a team discussion.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussion_comments/#create-a-comment
+
+// CreateTeamDiscussionComment 在团队讨论中创建新的评论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussion_comments/#create-a-comment
+func (s *OrganizationsService) CreateTeamDiscussionComment(team int, discussion int, comment *DiscussionComment) (*DiscussionComment, *Response, error)
+
+// DeleteHook deletes a specified Hook.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#delete-a-hook
+
+// DeleteHook 删除一个指定的 Hook.
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#delete-a-hook
+func (s *OrganizationsService) DeleteHook(org string, id int) (*Response, error)
+
 // DeleteTeam deletes a team.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/teams/#delete-team
@@ -2654,6 +4043,28 @@ func (s *OrganizationsService) CreateTeam(org string, team *Team) (*Team, *Respo
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#delete-team
 func (s *OrganizationsService) DeleteTeam(team int) (*Response, error)
 
+// DeleteTeamDiscussion deletes a discussion from a team's page.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussions/#delete-a-discussion
+
+// DeleteTeamDiscussion 从团队页面删除一个讨论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussions/#delete-a-discussion
+func (s *OrganizationsService) DeleteTeamDiscussion(team int, number int) (*Response, error)
+
+// DeleteTeamDiscussionComment deletes a comment on a team discussion.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussion_comments/#delete-a-comment
+
+// DeleteTeamDiscussionComment 删除团队讨论中的一条评论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussion_comments/#delete-a-comment
+func (s *OrganizationsService) DeleteTeamDiscussionComment(team int, discussion int, number int) (*Response, error)
+
 // Edit an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/#edit-an-organization
@@ -2663,17 +4074,32 @@ func (s *OrganizationsService) DeleteTeam(team int) (*Response, error)
 // GitHub API 文档: http://developer.github.com/v3/orgs/#edit-an-organization
 func (s *OrganizationsService) Edit(name string, org *Organization) (*Organization, *Response, error)
 
-// EditOrgMembership edits the membership for the authenticated user for the
-// specified organization.
+// EditHook updates a specified Hook.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#edit-a-hook
+
+// EditHook 更新一个指定的 Hook.
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#edit-a-hook
+func (s *OrganizationsService) EditHook(org string, id int, hook *Hook) (*Hook, *Response, error)
+
+// EditOrgMembership edits the membership for user in specified organization.
+// Passing an empty string for user will edit the membership for the
+// authenticated user. Setting the Role of another user adds them to the
+// organization, or invites them if they are not yet a member.
 //
 // GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#add-or-update-organization-membership
 // https://developer.github.com/v3/orgs/members/#edit-your-organization-membership
 
-// EditOrgMembership 编辑授权用户指定组织的一个成员.
+// EditOrgMembership 编辑 user 在指定组织中的成员资格. 传递空字符串给 user
+// 将编辑授权用户的成员资格. 设置其他用户的 Role 会将其加入该组织,
+// 如果还不是成员则邀请其加入.
 //
 // GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#add-or-update-organization-membership
 // https://developer.github.com/v3/orgs/members/#edit-your-organization-membership
-func (s *OrganizationsService) EditOrgMembership(org string, membership *Membership) (*Membership, *Response, error)
+func (s *OrganizationsService) EditOrgMembership(org, user string, membership *Membership) (*Membership, *Response, error)
 
 // EditTeam edits a team.
 //
@@ -2684,6 +4110,30 @@ func (s *OrganizationsService) EditOrgMembership(org string, membership *Members
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#edit-team
 func (s *OrganizationsService) EditTeam(id int, team *Team) (*Team, *Response, error)
 
+// EditTeamDiscussion edits the title and body text of a discussion post, or
+// pins and unpins it. Only the provided fields are updated.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussions/#edit-a-discussion
+
+// EditTeamDiscussion 编辑讨论帖的标题和内容, 或将其置顶和取消置顶.
+// 只更新所提供的字段.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussions/#edit-a-discussion
+func (s *OrganizationsService) EditTeamDiscussion(team int, number int, discussion *TeamDiscussion) (*TeamDiscussion, *Response, error)
+
+// EditTeamDiscussionComment edits the body text of a discussion comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussion_comments/#edit-a-comment
+
+// EditTeamDiscussionComment 编辑讨论评论的内容.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussion_comments/#edit-a-comment
+func (s *OrganizationsService) EditTeamDiscussionComment(team int, discussion int, number int, comment *DiscussionComment) (*DiscussionComment, *Response, error)
+
 // Get fetches an organization by name.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/#get-an-organization
@@ -2693,17 +4143,30 @@ func (s *OrganizationsService) EditTeam(id int, team *Team) (*Team, *Response, e
 // GitHub API 文档: http://developer.github.com/v3/orgs/#get-an-organization
 func (s *OrganizationsService) Get(org string) (*Organization, *Response, error)
 
-// GetOrgMembership gets the membership for the authenticated user for the
-// specified organization.
+// GetHook returns a single specified Hook.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#get-single-hook
+
+// GetHook 返回单个指定的 Hook.
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#get-single-hook
+func (s *OrganizationsService) GetHook(org string, id int) (*Hook, *Response, error)
+
+// GetOrgMembership gets the membership for user in the specified
+// organization. Passing an empty string for user will get the membership for
+// the authenticated user.
 //
 // GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#get-organization-membership
 // https://developer.github.com/v3/orgs/members/#get-your-organization-membership
 
-// GetOrgMembership 获取授权用户在指定组织的成员对象.
+// GetOrgMembership 获取 user 在指定组织中的成员资格. 传递空字符串给 user
+// 将获取授权用户的成员资格.
 //
 // GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#get-organization-membership
 // https://developer.github.com/v3/orgs/members/#get-your-organization-membership
-func (s *OrganizationsService) GetOrgMembership(org string) (*Membership, *Response, error)
+func (s *OrganizationsService) GetOrgMembership(org, user string) (*Membership, *Response, error)
 
 // GetTeam fetches a team by ID.
 //
@@ -2714,6 +4177,38 @@ func (s *OrganizationsService) GetOrgMembership(org string) (*Membership, *Respo
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#get-team
 func (s *OrganizationsService) GetTeam(team int) (*Team, *Response, error)
 
+// GetTeamBySlug fetches a team by the slug of its name within an
+// organization.
+//
+// GitHub API docs: https://developer.github.com/v3/teams/#get-team-by-name
+
+// GetTeamBySlug 以团队名称在组织中的 slug 获取一个团队.
+//
+// GitHub API 文档: https://developer.github.com/v3/teams/#get-team-by-name
+func (s *OrganizationsService) GetTeamBySlug(org, slug string) (*Team, *Response, error)
+
+// GetTeamDiscussion gets a specific discussion on a team's page.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussions/#get-a-single-discussion
+
+// GetTeamDiscussion 获取团队页面上的某个讨论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussions/#get-a-single-discussion
+func (s *OrganizationsService) GetTeamDiscussion(team int, number int) (*TeamDiscussion, *Response, error)
+
+// GetTeamDiscussionComment gets a specific comment on a team discussion.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussion_comments/#get-a-single-comment
+
+// GetTeamDiscussionComment 获取团队讨论中的某条评论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussion_comments/#get-a-single-comment
+func (s *OrganizationsService) GetTeamDiscussionComment(team int, discussion int, number int) (*DiscussionComment, *Response, error)
+
 // GetTeamMembership returns the membership status for a user in a team.
 //
 // GitHub API docs: https://developer.github.com/v3/orgs/teams/#get-team-membership
@@ -2723,6 +4218,19 @@ func (s *OrganizationsService) GetTeam(team int) (*Team, *Response, error)
 // GitHub API 文档: https://developer.github.com/v3/orgs/teams/#get-team-membership
 func (s *OrganizationsService) GetTeamMembership(team int, user string) (*Membership, *Response, error)
 
+// GetTeamRepo returns the specified repository if the team manages it, with
+// the Permissions field describing the permission of the team on it.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/#check-if-a-team-manages-a-repository
+
+// GetTeamRepo 如果团队管理指定的仓库, 则返回该仓库, 其 Permissions 字段
+// 描述该团队在仓库上的权限.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/#check-if-a-team-manages-a-repository
+func (s *OrganizationsService) GetTeamRepo(team int, owner string, repo string) (*Repository, *Response, error)
+
 // IsMember checks if a user is a member of an organization.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/members/#check-membership
@@ -2771,6 +4279,24 @@ func (s *OrganizationsService) IsTeamRepo(team int, owner string, repo string) (
 // GitHub API 文档: http://developer.github.com/v3/orgs/#list-user-organizations
 func (s *OrganizationsService) List(user string, opt *ListOptions) ([]Organization, *Response, error)
 
+// ListChildTeams lists the child teams of the specified team.
+//
+// GitHub API docs: https://developer.github.com/v3/teams/#list-child-teams
+
+// ListChildTeams 罗列指定团队的子团队.
+//
+// GitHub API 文档: https://developer.github.com/v3/teams/#list-child-teams
+func (s *OrganizationsService) ListChildTeams(team int, opt *ListOptions) ([]Team, *Response, error)
+
+// ListHooks lists all Hooks for the specified organization.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#list-hooks
+
+// ListHooks 罗列指定组织的所有 Hook.
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#list-hooks
+func (s *OrganizationsService) ListHooks(org string, opt *ListOptions) ([]Hook, *Response, error)
+
 // ListMembers lists the members for an organization. If the authenticated user is
 // an owner of the organization, this will return both concealed and public
 // members, otherwise it will only return public members.
@@ -2780,20 +4306,67 @@ func (s *OrganizationsService) List(user string, opt *ListOptions) ([]Organizati
 // ListMembers 罗列某组织成员. 如果授权用户是该组织的拥有者,
 // 它将返回隐藏的和公开的成员, 否则只返回公开成员.
 //
-// GitHub API 文档: http://developer.github.com/v3/orgs/members/#members-list
-func (s *OrganizationsService) ListMembers(org string, opt *ListMembersOptions) ([]User, *Response, error)
+// GitHub API 文档: http://developer.github.com/v3/orgs/members/#members-list
+func (s *OrganizationsService) ListMembers(org string, opt *ListMembersOptions) ([]User, *Response, error)
+
+// ListOrgMemberships lists the organization memberships for the authenticated
+// user.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#list-your-organization-memberships
+
+// ListOrgMemberships l罗列授权用户的组织成员.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#list-your-organization-memberships
+func (s *OrganizationsService) ListOrgMemberships(opt *ListOrgMembershipsOptions) ([]Membership, *Response, error)
+
+// ListOutsideCollaborators lists outside collaborators of an organization's
+// repositories. Only organization owners can see the full list.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/outside_collaborators/#list-outside-collaborators
+
+// ListOutsideCollaborators 罗列组织仓库的外部协作者. 只有组织拥有者才能看到完整列表.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/outside_collaborators/#list-outside-collaborators
+func (s *OrganizationsService) ListOutsideCollaborators(org string, opt *ListOutsideCollaboratorsOptions) ([]User, *Response, error)
+
+// ListPendingOrgInvitations lists the pending invitations of an organization.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/members/#list-pending-organization-invitations
+
+// ListPendingOrgInvitations 罗列某组织待处理的邀请.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/members/#list-pending-organization-invitations
+func (s *OrganizationsService) ListPendingOrgInvitations(org string, opt *ListOptions) ([]Invitation, *Response, error)
+
+// ListTeamDiscussionComments lists all comments on a team discussion.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/teams/discussion_comments/#list-comments
+
+// ListTeamDiscussionComments 罗列团队讨论中的所有评论.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/teams/discussion_comments/#list-comments
+func (s *OrganizationsService) ListTeamDiscussionComments(team int, discussion int, opt *DiscussionListOptions) ([]DiscussionComment, *Response, error)
 
-// ListOrgMemberships lists the organization memberships for the authenticated
-// user.
+// ListTeamDiscussions lists all discussions on a team's page. Pinned
+// discussions are listed like the others; check their Pinned field.
 //
 // GitHub API docs:
-// https://developer.github.com/v3/orgs/members/#list-your-organization-memberships
+// https://developer.github.com/v3/teams/discussions/#list-discussions
 
-// ListOrgMemberships l罗列授权用户的组织成员.
+// ListTeamDiscussions 罗列团队页面上的所有讨论. 置顶的讨论与其他讨论一样罗列;
+// 请检查其 Pinned 字段.
 //
 // GitHub API 文档:
-// https://developer.github.com/v3/orgs/members/#list-your-organization-memberships
-func (s *OrganizationsService) ListOrgMemberships(opt *ListOrgMembershipsOptions) ([]Membership, *Response, error)
+// https://developer.github.com/v3/teams/discussions/#list-discussions
+func (s *OrganizationsService) ListTeamDiscussions(team int, opt *DiscussionListOptions) ([]TeamDiscussion, *Response, error)
 
 // ListTeamMembers lists all of the users who are members of the specified team.
 //
@@ -2802,7 +4375,7 @@ func (s *OrganizationsService) ListOrgMemberships(opt *ListOrgMembershipsOptions
 // ListTeamMembers 罗列指定团队所有成员的用户.
 //
 // GitHub API 文档: http://developer.github.com/v3/orgs/teams/#list-team-members
-func (s *OrganizationsService) ListTeamMembers(team int, opt *ListOptions) ([]User, *Response, error)
+func (s *OrganizationsService) ListTeamMembers(team int, opt *OrganizationListTeamMembersOptions) ([]User, *Response, error)
 
 // ListTeamRepos lists the repositories that the specified team has access to.
 //
@@ -2829,6 +4402,15 @@ func (s *OrganizationsService) ListTeams(org string, opt *ListOptions) ([]Team,
 // https://developer.github.com/v3/orgs/teams/#list-user-teams
 func (s *OrganizationsService) ListUserTeams(opt *ListOptions) ([]Team, *Response, error)
 
+// PingHook triggers a 'ping' event to be sent to the Hook.
+//
+// GitHub API docs: https://developer.github.com/v3/orgs/hooks/#ping-a-hook
+
+// PingHook 触发向 Hook 发送一个 'ping' 事件.
+//
+// GitHub API 文档: https://developer.github.com/v3/orgs/hooks/#ping-a-hook
+func (s *OrganizationsService) PingHook(org string, id int) (*Response, error)
+
 // PublicizeMembership publicizes a user's membership in an organization.
 //
 // GitHub API docs:
@@ -2849,6 +4431,20 @@ func (s *OrganizationsService) PublicizeMembership(org, user string) (*Response,
 // GitHub API 文档: http://developer.github.com/v3/orgs/members/#remove-a-member
 func (s *OrganizationsService) RemoveMember(org, user string) (*Response, error)
 
+// RemoveOutsideCollaborator removes a user from the list of outside
+// collaborators; consequently, removing them from all the organization's
+// repositories.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/orgs/outside_collaborators/#remove-outside-collaborator
+
+// RemoveOutsideCollaborator 从外部协作者列表中删除一个用户;
+// 相应地, 将其从组织所有仓库中移除.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/orgs/outside_collaborators/#remove-outside-collaborator
+func (s *OrganizationsService) RemoveOutsideCollaborator(org string, user string) (*Response, error)
+
 // RemoveTeamMember removes a user from a team.
 //
 // GitHub API docs: http://developer.github.com/v3/orgs/teams/#remove-team-member
@@ -2980,6 +4576,7 @@ type PullRequestComment struct {
 	User      *User      `json:"user,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Reactions *Reactions `json:"reactions,omitempty"`
 }
 
 func (p PullRequestComment) String() string
@@ -3308,6 +4905,184 @@ type RateLimits struct {
 
 func (r RateLimits) String() string
 
+// Reaction represents a GitHub reaction.
+
+// Reaction 表示一个 GitHub 反应.
+type Reaction struct {
+	ID   *int  `json:"id,omitempty"`
+	User *User `json:"user,omitempty"`
+
+	// Content is the type of reaction. Possible values are: "+1", "-1",
+	// "laugh", "confused", "heart", "hooray".
+
+	// Content 为反应的类型. 可选值: "+1", "-1", "laugh", "confused",
+	// "heart", "hooray".
+	Content *string `json:"content,omitempty"`
+}
+
+func (r Reaction) String() string
+
+// Reactions represents a summary of the reactions on an issue or comment.
+
+// Reactions 表示某问题或评论上反应的汇总.
+type Reactions struct {
+	TotalCount *int    `json:"total_count,omitempty"`
+	PlusOne    *int    `json:"+1,omitempty"`
+	MinusOne   *int    `json:"-1,omitempty"`
+	Laugh      *int    `json:"laugh,omitempty"`
+	Confused   *int    `json:"confused,omitempty"`
+	Heart      *int    `json:"heart,omitempty"`
+	Hooray     *int    `json:"hooray,omitempty"`
+	URL        *string `json:"url,omitempty"`
+}
+
+// ReactionsService handles communication with the reactions related methods
+// of the GitHub API.
+//
+// GitHub API docs: https://developer.github.com/v3/reactions/
+
+// ReactionsService 处理与反应相关的 GitHub API 通信方法.
+//
+// GitHub API 文档: https://developer.github.com/v3/reactions/
+type ReactionsService struct {
+	// contains filtered or unexported fields
+}
+
+// CreateCommentReaction creates a reaction for a commit comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#create-reaction-for-a-commit-comment
+
+// CreateCommentReaction 为某提交评论创建一个反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#create-reaction-for-a-commit-comment
+func (s *ReactionsService) CreateCommentReaction(owner, repo string, id int, content string) (*Reaction, *Response, error)
+
+// CreateIssueCommentReaction creates a reaction for an issue comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#create-reaction-for-an-issue-comment
+
+// CreateIssueCommentReaction 为某问题评论创建一个反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#create-reaction-for-an-issue-comment
+func (s *ReactionsService) CreateIssueCommentReaction(owner, repo string, id int, content string) (*Reaction, *Response, error)
+
+// CreateIssueReaction creates a reaction for an issue.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#create-reaction-for-an-issue
+
+// CreateIssueReaction 为某问题创建一个反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#create-reaction-for-an-issue
+func (s *ReactionsService) CreateIssueReaction(owner, repo string, number int, content string) (*Reaction, *Response, error)
+
+// CreatePullRequestCommentReaction creates a reaction for a pull request
+// review comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#create-reaction-for-a-pull-request-review-comment
+
+// CreatePullRequestCommentReaction 为某上拉请求审查评论创建一个反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#create-reaction-for-a-pull-request-review-comment
+func (s *ReactionsService) CreatePullRequestCommentReaction(owner, repo string, id int, content string) (*Reaction, *Response, error)
+
+// DeleteReaction deletes a reaction.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#delete-a-reaction
+
+// DeleteReaction 删除一个反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#delete-a-reaction
+func (s *ReactionsService) DeleteReaction(id int) (*Response, error)
+
+// ListCommentReactions lists the reactions for a commit comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#list-reactions-for-a-commit-comment
+
+// ListCommentReactions 罗列某提交评论的反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#list-reactions-for-a-commit-comment
+func (s *ReactionsService) ListCommentReactions(owner, repo string, id int, opt *ListOptions) ([]Reaction, *Response, error)
+
+// ListIssueCommentReactions lists the reactions for an issue comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#list-reactions-for-an-issue-comment
+
+// ListIssueCommentReactions 罗列某问题评论的反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#list-reactions-for-an-issue-comment
+func (s *ReactionsService) ListIssueCommentReactions(owner, repo string, id int, opt *ListOptions) ([]Reaction, *Response, error)
+
+// ListIssueReactions lists the reactions for an issue.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#list-reactions-for-an-issue
+
+// ListIssueReactions 罗列某问题的反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#list-reactions-for-an-issue
+func (s *ReactionsService) ListIssueReactions(owner, repo string, number int, opt *ListOptions) ([]Reaction, *Response, error)
+
+// ListPullRequestCommentReactions lists the reactions for a pull request
+// review comment.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/reactions/#list-reactions-for-a-pull-request-review-comment
+
+// ListPullRequestCommentReactions 罗列某上拉请求审查评论的反应.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/reactions/#list-reactions-for-a-pull-request-review-comment
+func (s *ReactionsService) ListPullRequestCommentReactions(owner, repo string, id int, opt *ListOptions) ([]Reaction, *Response, error)
+
+// RefOperationOptions specifies the optional parameters to the
+// GitService.DeleteRefs and GitService.UpdateRefs methods.
+
+// RefOperationOptions 指定 GitService.DeleteRefs 和 GitService.UpdateRefs
+// 方法的可选参数.
+type RefOperationOptions struct {
+	// DryRun reports what would be done for each ref without changing it.
+
+	// DryRun 报告每个引用将被执行的操作, 但并不更改它.
+	DryRun bool
+
+	// Force allows UpdateRefs to move refs in a non fast-forward way.
+
+	// Force 允许 UpdateRefs 以非快进方式移动引用.
+	Force bool
+}
+
+// RefOperationResult reports the outcome of a bulk operation on a single ref.
+
+// RefOperationResult 报告批量操作中单个引用的结果.
+type RefOperationResult struct {
+	Ref string
+
+	// Reference is the updated ref returned by UpdateRefs. It is nil for
+	// DeleteRefs and in dry-run mode.
+
+	// Reference 为 UpdateRefs 返回的已更新引用. 对于 DeleteRefs 及 dry-run
+	// 模式它为 nil.
+	Reference *Reference
+
+	Response *Response
+	Err      error
+}
+
 // Reference represents a GitHub reference.
 
 // Reference 表示一个 GitHub 引用.
@@ -3326,6 +5101,17 @@ func (r Reference) String() string
 type ReferenceListOptions struct {
 	Type string `url:"-"`
 
+	// Pattern filters the listed refs with a shell glob as understood by
+	// path.Match, matched against the ref name without its "refs/" prefix,
+	// e.g. "heads/release-*". The filter is applied client side, after Type.
+	// A malformed pattern makes ListRefs return path.ErrBadPattern before any
+	// request is made.
+
+	// Pattern 以 path.Match 所支持的 shell glob 过滤所罗列的引用, 匹配去掉
+	// "refs/" 前缀的引用名, 例如 "heads/release-*". 过滤在客户端进行, 在 Type 之后.
+	// 格式错误的 pattern 使 ListRefs 在发起任何请求前返回 path.ErrBadPattern.
+	Pattern string `url:"-"`
+
 	ListOptions
 }
 
@@ -3349,6 +5135,16 @@ type ReleaseAsset struct {
 
 func (r ReleaseAsset) String() string
 
+// Rename contains details for a renamed timeline event.
+
+// Rename 包含 renamed 时间线事件的详细信息.
+type Rename struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+func (r Rename) String() string
+
 // RepoStatus represents the status of a repository at a particular reference.
 
 // RepoStatus 表示某仓库中的一个特定引用状态.
@@ -3783,6 +5579,17 @@ func (s *RepositoriesService) GetLatestPagesBuild(owner string, repo string) (*P
 // https://developer.github.com/v3/repos/pages/#get-information-about-a-pages-site
 func (s *RepositoriesService) GetPagesInfo(owner string, repo string) (*Pages, *Response, error)
 
+// GetPullRequestTemplate gets the pull request template of a repository. The
+// first file found among .github/PULL_REQUEST_TEMPLATE.md,
+// PULL_REQUEST_TEMPLATE.md and docs/PULL_REQUEST_TEMPLATE.md is used, with or
+// without the .md extension. A nil template is returned if there is none.
+
+// GetPullRequestTemplate 获取某仓库的上拉请求模版. 使用在
+// .github/PULL_REQUEST_TEMPLATE.md, PULL_REQUEST_TEMPLATE.md 和
+// docs/PULL_REQUEST_TEMPLATE.md 中找到的第一个文件, 可带或不带 .md 扩展名.
+// 如果没有模版, 返回的模版为 nil.
+func (s *RepositoriesService) GetPullRequestTemplate(owner, repo string, opt *RepositoryContentGetOptions) (*IssueTemplate, *Response, error)
+
 // GetReadme gets the Readme file for the repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/contents/#get-the-readme
@@ -3826,6 +5633,17 @@ func (s *RepositoriesService) GetReleaseAsset(owner, repo string, id int) (*Rele
 // GitHub API 文档: http://developer.github.com/v3/repos/collaborators/#get
 func (s *RepositoriesService) IsCollaborator(owner, repo, user string) (bool, *Response, error)
 
+// License gets the contents of a repository's license if one is detected.
+//
+// GitHub API docs:
+// https://developer.github.com/v3/licenses/#get-the-contents-of-a-repositorys-license
+
+// License 获取检测到的仓库许可证内容.
+//
+// GitHub API 文档:
+// https://developer.github.com/v3/licenses/#get-the-contents-of-a-repositorys-license
+func (s *RepositoriesService) License(owner, repo string) (*RepositoryLicense, *Response, error)
+
 // List the repositories for a user. Passing the empty string will list
 // repositories for the authenticated user.
 //
@@ -3882,13 +5700,15 @@ func (s *RepositoriesService) ListByOrg(org string, opt *RepositoryListByOrgOpti
 func (s *RepositoriesService) ListCodeFrequency(owner, repo string) ([]WeeklyStats, *Response, error)
 
 // ListCollaborators lists the Github users that have access to the repository.
+// The Permissions field of each user is populated.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/collaborators/#list
 
-// ListCollaborators 罗列可存取某仓库的 Github 用户.
+// ListCollaborators 罗列可存取某仓库的 Github 用户. 每个用户的 Permissions
+// 字段会被填充.
 //
 // GitHub API 文档: http://developer.github.com/v3/repos/collaborators/#list
-func (s *RepositoriesService) ListCollaborators(owner, repo string, opt *ListOptions) ([]User, *Response, error)
+func (s *RepositoriesService) ListCollaborators(owner, repo string, opt *ListCollaboratorsOptions) ([]User, *Response, error)
 
 // ListComments lists all the comments for the repository.
 //
@@ -4013,6 +5833,18 @@ func (s *RepositoriesService) ListForks(owner, repo string, opt *RepositoryListF
 // GitHub API 文档: http://developer.github.com/v3/repos/hooks/#list
 func (s *RepositoriesService) ListHooks(owner, repo string, opt *ListOptions) ([]Hook, *Response, error)
 
+// ListIssueTemplates lists the issue templates of a repository using
+// GetContents. Every Markdown file of the .github/ISSUE_TEMPLATE directory is
+// returned, sorted by path. If the directory does not exist, the single-file
+// variants .github/ISSUE_TEMPLATE.md, ISSUE_TEMPLATE.md and
+// docs/ISSUE_TEMPLATE.md are tried in order.
+
+// ListIssueTemplates 使用 GetContents 罗列某仓库的问题模版. 返回
+// .github/ISSUE_TEMPLATE 目录中的每个 Markdown 文件, 按路径排序.
+// 如果该目录不存在, 依次尝试单文件形式 .github/ISSUE_TEMPLATE.md,
+// ISSUE_TEMPLATE.md 和 docs/ISSUE_TEMPLATE.md.
+func (s *RepositoriesService) ListIssueTemplates(owner, repo string, opt *RepositoryContentGetOptions) ([]IssueTemplate, *Response, error)
+
 // ListKeys lists the deploy keys for a repository.
 //
 // GitHub API docs: http://developer.github.com/v3/repos/keys/#list
@@ -4253,6 +6085,7 @@ type Repository struct {
 	Source           *Repository      `json:"source,omitempty"`
 	Organization     *Organization    `json:"organization,omitempty"`
 	Permissions      *map[string]bool `json:"permissions,omitempty"`
+	License          *License         `json:"license,omitempty"`
 
 	// Additional mutable fields when creating and editing a repository
 
@@ -4326,6 +6159,7 @@ type RepositoryComment struct {
 	User      *User      `json:"user,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Reactions *Reactions `json:"reactions,omitempty"`
 
 	// User-mutable fields
 	Body *string `json:"body"`
@@ -4425,6 +6259,33 @@ type RepositoryCreateForkOptions struct {
 	Organization string `url:"organization,omitempty"`
 }
 
+// RepositoryLicense represents the license file of a repository, along with
+// the license GitHub detected in it.
+
+// RepositoryLicense 表示仓库的许可证文件, 以及 GitHub 从中检测出的许可证.
+type RepositoryLicense struct {
+	Name *string `json:"name,omitempty"`
+	Path *string `json:"path,omitempty"`
+
+	SHA         *string  `json:"sha,omitempty"`
+	Size        *int     `json:"size,omitempty"`
+	URL         *string  `json:"url,omitempty"`
+	HTMLURL     *string  `json:"html_url,omitempty"`
+	GitURL      *string  `json:"git_url,omitempty"`
+	DownloadURL *string  `json:"download_url,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	Content     *string  `json:"content,omitempty"`
+	Encoding    *string  `json:"encoding,omitempty"`
+	License     *License `json:"license,omitempty"`
+}
+
+// Decode decodes the content of the license file if it is base64 encoded.
+
+// Decode 解码许可证文件内容, 如果是以 base64 编码的话.
+func (l *RepositoryLicense) Decode() ([]byte, error)
+
+func (l RepositoryLicense) String() string
+
 // RepositoryListAllOptions specifies the optional parameters to the
 // RepositoriesService.ListAll method.
 
@@ -4663,6 +6524,173 @@ type ServiceHook struct {
 
 func (s *ServiceHook) String() string
 
+// SignatureVerification represents the signature of a git commit or tag and
+// GitHub's verification of it.
+
+// SignatureVerification 表示 git 提交或标签的签名及 GitHub 对它的验证结果.
+type SignatureVerification struct {
+	Verified *bool `json:"verified,omitempty"`
+
+	// Reason explains the value of Verified. Possible values include: valid,
+	// unsigned, unknown_key, bad_email, unverified_email, no_user,
+	// unknown_signature_type, malformed_signature, invalid and expired_key.
+
+	// Reason 说明 Verified 的值. 可能的值包括: valid, unsigned, unknown_key,
+	// bad_email, unverified_email, no_user, unknown_signature_type,
+	// malformed_signature, invalid 和 expired_key.
+	Reason *string `json:"reason,omitempty"`
+
+	// Signature is the ASCII armored signature and Payload the signed
+	// content.
+
+	// Signature 为 ASCII armored 格式的签名, Payload 为被签名的内容.
+	Signature *string `json:"signature,omitempty"`
+	Payload   *string `json:"payload,omitempty"`
+}
+
+// CheckSignature verifies the OpenPGP Signature of v over its Payload against
+// keyring, independently of the Verified value reported by GitHub. It returns
+// the entity that made the signature. An error is returned if v is unsigned or
+// the signature is not valid for any key of keyring.
+
+// CheckSignature 以 keyring 校验 v 中覆盖 Payload 的 OpenPGP 签名,
+// 与 GitHub 报告的 Verified 值无关. 它返回做出签名的实体.
+// 如果 v 未签名或签名对 keyring 中任何密钥都无效则返回错误.
+func (v *SignatureVerification) CheckSignature(keyring openpgp.KeyRing) (*openpgp.Entity, error)
+
+func (v SignatureVerification) String() string
+
+// StaleReport summarizes a run of StaleSweeper.Sweep on a repository.
+
+// StaleReport 汇总 StaleSweeper.Sweep 在某仓库上的一次运行.
+type StaleReport struct {
+	Owner string
+	Repo  string
+
+	// Marked lists the issues that received the stale label and warning
+	// comment, and Closed the issues that were closed after the grace period.
+	// In dry-run mode they list what would have been done.
+
+	// Marked 罗列被加上过期标记和警告评论的问题, Closed 罗列宽限期后被关闭的问题.
+	// 在 dry-run 模式下它们罗列将被执行的操作.
+	Marked []Issue
+	Closed []Issue
+
+	// Unmarked lists the issues whose stale label was removed because of
+	// activity during the grace period, or because they received an exempt
+	// label after being marked.
+
+	// Unmarked 罗列因宽限期内有活动, 或在标记后被加上豁免标记,
+	// 而被去掉过期标记的问题.
+	Unmarked []Issue
+
+	// Errors holds the errors met on individual issues. They do not stop the
+	// sweep.
+
+	// Errors 保存处理单个问题时遇到的错误. 它们不会停止清理.
+	Errors []error
+}
+
+// StaleSweeper finds the issues and pull requests of a repository that have
+// had no activity for DaysUntilStale days, marks them with StaleLabel and a
+// warning comment, and closes them if they stay inactive for DaysUntilClose
+// more days.
+//
+// Sweep lists open issues with IssuesService.ListByRepo in two passes. The
+// first pass looks for issues to mark: it sorts by "updated" in "asc"
+// direction and stops at the first issue updated less than DaysUntilStale
+// days ago, skipping issues that already carry StaleLabel or an exempt label.
+// Marking bumps the update time of an issue, so marked issues can not be found
+// that way; the second pass lists them with Labels set to StaleLabel instead.
+//
+// For each marked issue, the marking time is the time of the latest
+// "labeled" event for StaleLabel returned by IssuesService.ListIssueEvents.
+// Comments made after it are listed with IssuesService.ListComments, using
+// IssueListCommentsOptions.Since set to the marking time. The warning comment
+// posted by the sweeper ends with the hidden marker "<!-- stale-sweeper -->"
+// and is not counted as activity; any other comment is. An issue that now
+// carries an exempt label, or that had activity, gets StaleLabel removed and
+// is reported in StaleReport.Unmarked; an issue without activity that was
+// marked at least DaysUntilClose days ago is closed.
+
+// StaleSweeper 查找某仓库中已 DaysUntilStale 天没有活动的问题和上拉请求,
+// 用 StaleLabel 和一条警告评论标记它们, 如果它们再保持 DaysUntilClose 天
+// 没有活动, 则关闭它们.
+//
+// Sweep 分两轮调用 IssuesService.ListByRepo 罗列打开的问题. 第一轮查找要标记的
+// 问题: 以 "updated" 和 "asc" 方向排序, 在遇到第一个更新时间不足
+// DaysUntilStale 天的问题时停止, 并跳过已带有 StaleLabel 或豁免标记的问题.
+// 标记会更新问题的更新时间, 因此已标记的问题无法以这种方式找到;
+// 第二轮改为以 StaleLabel 作为 Labels 罗列它们.
+//
+// 对每个已标记的问题, 标记时间为 IssuesService.ListIssueEvents 返回的
+// StaleLabel 最近一次 "labeled" 事件的时间. 之后的评论通过
+// IssuesService.ListComments 罗列, 其 IssueListCommentsOptions.Since
+// 设为标记时间. 清理器发布的警告评论以隐藏标识 "<!-- stale-sweeper -->" 结尾,
+// 不算作活动; 其它任何评论都算作活动. 当前带有豁免标记或有活动的问题
+// 被去掉 StaleLabel, 并在 StaleReport.Unmarked 中报告; 没有活动且标记已满
+// DaysUntilClose 天的问题被关闭.
+type StaleSweeper struct {
+	// DaysUntilStale is the number of days of inactivity before an issue is
+	// marked as stale. Default is 60.
+
+	// DaysUntilStale 为问题被标记为过期前的无活动天数. 缺省为 60.
+	DaysUntilStale int
+
+	// DaysUntilClose is the grace period, in days, between marking and
+	// closing. Default is 7.
+
+	// DaysUntilClose 为标记到关闭之间的宽限天数. 缺省为 7.
+	DaysUntilClose int
+
+	// StaleLabel is the label applied to stale issues. Default is "stale".
+
+	// StaleLabel 为加在过期问题上的标记. 缺省为 "stale".
+	StaleLabel string
+
+	// ExemptLabels lists labels that keep an issue from being marked or
+	// closed. A marked issue that later gets one of them is unmarked rather
+	// than closed.
+
+	// ExemptLabels 罗列使问题不被标记或关闭的标记. 已标记的问题之后
+	// 如果被加上其中之一, 会被取消标记而不是被关闭.
+	ExemptLabels []string
+
+	// MarkComment is posted when an issue is marked, followed by the hidden
+	// marker, and CloseComment when it is closed. An empty CloseComment posts
+	// nothing.
+
+	// MarkComment 在问题被标记时发布, 其后跟随隐藏标识, CloseComment
+	// 在问题被关闭时发布. CloseComment 为空时不发布.
+	MarkComment  string
+	CloseComment string
+
+	// SkipPullRequests restricts the sweep to issues.
+
+	// SkipPullRequests 将清理限定于问题.
+	SkipPullRequests bool
+
+	// DryRun reports what would be done without changing anything.
+
+	// DryRun 报告将被执行的操作, 但不做任何更改.
+	DryRun bool
+	// contains filtered or unexported fields
+}
+
+// NewStaleSweeper returns a StaleSweeper that uses client, with default
+// settings.
+
+// NewStaleSweeper 返回使用 client 及缺省设置的 StaleSweeper.
+func NewStaleSweeper(client *Client) *StaleSweeper
+
+// Sweep marks, unmarks and closes the stale issues of the owner/repo
+// repository and reports what was done. The returned error is only set if
+// listing the issues failed.
+
+// Sweep 标记, 取消标记和关闭 owner/repo 仓库中的过期问题, 并报告执行的操作.
+// 只有在罗列问题失败时才返回错误.
+func (s *StaleSweeper) Sweep(owner, repo string) (*StaleReport, *Response, error)
+
 // Subscription identifies a repository or thread subscription.
 
 // Subscription 标识仓库订阅或订阅线程.
@@ -4694,6 +6722,12 @@ type Tag struct {
 	Message *string       `json:"message,omitempty"`
 	Tagger  *CommitAuthor `json:"tagger,omitempty"`
 	Object  *GitObject    `json:"object,omitempty"`
+
+	// Verification holds the signature of the tag and whether GitHub could
+	// verify it.
+
+	// Verification 包含标签的签名以及 GitHub 是否能够验证它.
+	Verification *SignatureVerification `json:"verification,omitempty"`
 }
 
 // Team represents a team within a GitHub organization. Teams are used to manage
@@ -4703,16 +6737,98 @@ type Tag struct {
 type Team struct {
 	ID           *int          `json:"id,omitempty"`
 	Name         *string       `json:"name,omitempty"`
+	Description  *string       `json:"description,omitempty"`
 	URL          *string       `json:"url,omitempty"`
 	Slug         *string       `json:"slug,omitempty"`
 	Permission   *string       `json:"permission,omitempty"`
 	MembersCount *int          `json:"members_count,omitempty"`
 	ReposCount   *int          `json:"repos_count,omitempty"`
 	Organization *Organization `json:"organization,omitempty"`
+
+	// Privacy identifies the level of privacy this team should have.
+	// Possible values are:
+	//     secret - only visible to organization owners and members of this team
+	//     closed - visible to all members of this organization
+	// Default is "secret". Nested teams can not be secret.
+
+	// Privacy 标识该团队的隐私级别. 可能的值有:
+	//     secret - 只对组织拥有者和该团队成员可见
+	//     closed - 对该组织所有成员可见
+	// 缺省为 "secret". 嵌套团队不能为 secret.
+	Privacy *string `json:"privacy,omitempty"`
+
+	// Parent is the parent team of a nested team.
+
+	// Parent 为嵌套团队的父团队.
+	Parent *Team `json:"parent,omitempty"`
+
+	// ParentTeamID is the ID of the parent team. It is only used when
+	// creating or editing a nested team; read Parent otherwise.
+
+	// ParentTeamID 为父团队的 ID. 它只在创建或编辑嵌套团队时使用;
+	// 其它情况请读取 Parent.
+	ParentTeamID *int `json:"parent_team_id,omitempty"`
 }
 
 func (t Team) String() string
 
+// TeamConfig describes the desired state of a team in an OrgConfig.
+
+// TeamConfig 描述 OrgConfig 中某团队期望的状态.
+type TeamConfig struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Privacy     string `json:"privacy,omitempty"`
+
+	// Parent is the name of the parent team, if any.
+
+	// 如果有, Parent 为父团队的名称.
+	Parent string `json:"parent,omitempty"`
+
+	Maintainers []string `json:"maintainers,omitempty"`
+	Members     []string `json:"members,omitempty"`
+
+	// Repos maps repository names to the permission granted to the team:
+	// pull, push or admin.
+
+	// Repos 映射仓库名称到授予团队的权限: pull, push 或 admin.
+	Repos map[string]string `json:"repos,omitempty"`
+}
+
+// TeamDiscussion represents a GitHub discussion in a team.
+
+// TeamDiscussion 表示团队中的一个 GitHub 讨论.
+type TeamDiscussion struct {
+	Author        *User      `json:"author,omitempty"`
+	Body          *string    `json:"body,omitempty"`
+	BodyHTML      *string    `json:"body_html,omitempty"`
+	BodyVersion   *string    `json:"body_version,omitempty"`
+	CommentsCount *int       `json:"comments_count,omitempty"`
+	CommentsURL   *string    `json:"comments_url,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	LastEditedAt  *Timestamp `json:"last_edited_at,omitempty"`
+	HTMLURL       *string    `json:"html_url,omitempty"`
+	Number        *int       `json:"number,omitempty"`
+	TeamURL       *string    `json:"team_url,omitempty"`
+	Title         *string    `json:"title,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	URL           *string    `json:"url,omitempty"`
+	Reactions     *Reactions `json:"reactions,omitempty"`
+
+	// Pinned discussions are shown at the top of the team's page.
+
+	// 置顶的讨论显示在团队页面的顶部.
+	Pinned *bool `json:"pinned,omitempty"`
+
+	// Private discussions are only visible to team members and organization
+	// owners. It can only be set when creating a discussion.
+
+	// 私有讨论只对团队成员和组织拥有者可见. 只能在创建讨论时设置.
+	Private *bool `json:"private,omitempty"`
+}
+
+func (d TeamDiscussion) String() string
+
 // TextMatch represents a text match for a SearchResult
 
 // TextMatch 表示 SearchResult 的文本匹配.
@@ -4726,6 +6842,134 @@ type TextMatch struct {
 
 func (tm TextMatch) String() string
 
+// Timeline represents an entry of the timeline of an issue or pull request.
+// Which fields are populated depends on Event.
+
+// Timeline 表示问题或上拉请求时间线中的一个条目. 填充哪些字段取决于 Event.
+type Timeline struct {
+	ID  *int    `json:"id,omitempty"`
+	URL *string `json:"url,omitempty"`
+
+	// Event identifies the type of the entry. In addition to the values
+	// documented on IssueEvent, possible values include:
+	//
+	//     cross-referenced
+	//       The issue was referenced from another issue or pull request,
+	//       described by Source.
+	//
+	//     committed
+	//       A commit was added to the pull request's branch. SHA, Message,
+	//       Author and Committer describe the commit.
+	//
+	//     commented
+	//       A comment was added. Body holds its text.
+	//
+	//     reviewed
+	//       The pull request was reviewed. State and Body describe the
+	//       review.
+	//
+	//     labeled, unlabeled
+	//       Label was added to or removed from the issue.
+	//
+	//     assigned, unassigned
+	//       Assignee was assigned to or unassigned from the issue.
+	//
+	//     milestoned, demilestoned
+	//       The issue was added to or removed from Milestone.
+	//
+	//     renamed
+	//       The title was changed as described by Rename.
+	//
+	//     locked, unlocked
+	//       The conversation was locked or unlocked.
+
+	// Event 标识条目的类型. 除了 IssueEvent 中所述的值外, 可能的值还包括:
+	//
+	//     cross-referenced
+	//       该问题被 Source 所描述的另一个问题或上拉请求引用.
+	//
+	//     committed
+	//       上拉请求分支上增加了一个提交. SHA, Message, Author 和 Committer
+	//       描述该提交.
+	//
+	//     commented
+	//       增加了一个评论. Body 为其文本.
+	//
+	//     reviewed
+	//       上拉请求被审查. State 和 Body 描述该审查.
+	//
+	//     labeled, unlabeled
+	//       该问题被增加或去掉了 Label.
+	//
+	//     assigned, unassigned
+	//       该问题被指派给 Assignee 或取消指派.
+	//
+	//     milestoned, demilestoned
+	//       该问题被加入或移出 Milestone.
+	//
+	//     renamed
+	//       标题按 Rename 所述被更改.
+	//
+	//     locked, unlocked
+	//       会话被锁定或解锁.
+	Event *string `json:"event,omitempty"`
+
+	// Actor is the user who generated the entry.
+
+	// Actor 为产生该条目的用户.
+	Actor *User `json:"actor,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CommitID is the SHA of the commit that referenced or closed the issue,
+	// if applicable.
+
+	// 如果有, CommitID 为引用或关闭该问题的提交 SHA.
+	CommitID  *string `json:"commit_id,omitempty"`
+	CommitURL *string `json:"commit_url,omitempty"`
+
+	Label     *Label          `json:"label,omitempty"`
+	Assignee  *User           `json:"assignee,omitempty"`
+	Milestone *Milestone      `json:"milestone,omitempty"`
+	Rename    *Rename         `json:"rename,omitempty"`
+	Source    *TimelineSource `json:"source,omitempty"`
+
+	// Populated for committed entries.
+
+	// 为 committed 条目填充.
+	SHA       *string       `json:"sha,omitempty"`
+	Message   *string       `json:"message,omitempty"`
+	Author    *CommitAuthor `json:"author,omitempty"`
+	Committer *CommitAuthor `json:"committer,omitempty"`
+
+	// Populated for commented and reviewed entries.
+
+	// 为 commented 和 reviewed 条目填充.
+	User        *User      `json:"user,omitempty"`
+	Body        *string    `json:"body,omitempty"`
+	State       *string    `json:"state,omitempty"`
+	HTMLURL     *string    `json:"html_url,omitempty"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+}
+
+func (t Timeline) String() string
+
+// TimelineSource represents the issue or pull request that cross-referenced
+// an issue in a timeline.
+
+// TimelineSource 表示在时间线中交叉引用某问题的问题或上拉请求.
+type TimelineSource struct {
+	Type *string `json:"type,omitempty"`
+
+	// Issue is the referencing issue. Its PullRequestLinks field is set when
+	// the reference comes from a pull request.
+
+	// Issue 为引用方问题. 当引用来自上拉请求时, 其 PullRequestLinks 字段被设置.
+	Issue *Issue `json:"issue,omitempty"`
+}
+
+func (t TimelineSource) String() string
+
 // Timestamp represents a time that can be unmarshalled from a JSON string
 // formatted as either an RFC3339 or Unix timestamp. This is necessary for some
 // fields since the GitHub API is inconsistent in how it represents times. All
@@ -4757,10 +7001,47 @@ func (t *Timestamp) UnmarshalJSON(data []byte) (err error)
 type Tree struct {
 	SHA     *string     `json:"sha,omitempty"`
 	Entries []TreeEntry `json:"tree,omitempty"`
+
+	// Truncated is true when a recursive tree listing exceeded the limits of
+	// the API and Entries is incomplete.
+
+	// Truncated 为 true 表示递归树列表超出 API 限制, Entries 不完整.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (t Tree) String() string
 
+// TreeChange represents a file that differs between two trees, as reported by
+// GitService.DiffTrees.
+
+// TreeChange 表示两棵树之间有差异的文件, 由 GitService.DiffTrees 报告.
+type TreeChange struct {
+	// Status is one of "added", "removed", "modified" or "renamed".
+
+	// Status 为 "added", "removed", "modified" 或 "renamed" 之一.
+	Status *string `json:"status,omitempty"`
+
+	// Path, SHA and Mode describe the file in the head tree. They are nil
+	// for removed files. Mode is a git file mode as in TreeEntry.Mode.
+
+	// Path, SHA 和 Mode 描述 head 树中的文件. 对于删除的文件它们为 nil.
+	// Mode 为 git 文件模式, 与 TreeEntry.Mode 相同.
+	Path *string `json:"path,omitempty"`
+	SHA  *string `json:"sha,omitempty"`
+	Mode *string `json:"mode,omitempty"`
+
+	// PreviousPath, PreviousSHA and PreviousMode describe the file in the
+	// base tree. They are nil for added files.
+
+	// PreviousPath, PreviousSHA 和 PreviousMode 描述 base 树中的文件.
+	// 对于增加的文件它们为 nil.
+	PreviousPath *string `json:"previous_path,omitempty"`
+	PreviousSHA  *string `json:"previous_sha,omitempty"`
+	PreviousMode *string `json:"previous_mode,omitempty"`
+}
+
+func (t TreeChange) String() string
+
 // TreeEntry represents the contents of a tree structure. TreeEntry can represent
 // either a blob, a commit (in the case of a submodule), or another tree.
 
@@ -4774,8 +7055,82 @@ type TreeEntry struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// FileMode returns the fs.FileMode corresponding to the git mode of the entry:
+// 100644 maps to 0644, 100755 to 0755, 040000 to fs.ModeDir|0755, 120000 to
+// fs.ModeSymlink|0777 and 160000 (a submodule) to fs.ModeIrregular.
+
+// FileMode 返回条目 git 模式所对应的 fs.FileMode:
+// 100644 对应 0644, 100755 对应 0755, 040000 对应 fs.ModeDir|0755,
+// 120000 对应 fs.ModeSymlink|0777, 160000 (子模块) 对应 fs.ModeIrregular.
+func (t TreeEntry) FileMode() fs.FileMode
+
+// MarshalJSON implements the json.Marshaler interface. An entry with a Path
+// but neither SHA nor Content is encoded with "sha": null, which
+// GitService.CreateTree takes as removing Path from the base tree. Other
+// entries are encoded as usual.
+
+// MarshalJSON 实现了 json.Marshaler 接口. 有 Path 但既无 SHA 也无 Content 的
+// 条目会被编码为 "sha": null, GitService.CreateTree 将其视为从基础树中删除 Path.
+// 其它条目按常规编码.
+func (t TreeEntry) MarshalJSON() ([]byte, error)
+
 func (t TreeEntry) String() string
 
+// TreeFS is a read-only fs.FS view of the tree of a commit. The tree is listed
+// once with GitService.GetTree in recursive mode. A truncated response may stop
+// partway through any directory, so when Tree.Truncated is set the recursive
+// listing is discarded and every directory is listed with a non-recursive
+// GetTree as it is walked. File contents are fetched lazily with GitService.GetBlob and cached
+// by blob SHA.
+//
+// Symbolic links are not followed: reading one returns its target path.
+// Submodules show up as empty entries with mode fs.ModeIrregular.
+//
+// TreeFS implements fs.ReadDirFS, fs.ReadFileFS and fs.StatFS, so it can be
+// passed to fs.WalkDir and similar helpers. It is safe for concurrent use.
+
+// TreeFS 是某提交树的只读 fs.FS 视图. 树以递归模式调用 GitService.GetTree
+// 一次性列出. 截断的响应可能在任意目录中途停止, 因此当 Tree.Truncated 被设置时,
+// 递归列表会被丢弃, 每个目录都在遍历时以非递归的 GetTree 列出.
+// 文件内容通过 GitService.GetBlob 延迟获取, 并以 blob SHA 缓存.
+//
+// 不跟随符号链接: 读取符号链接返回其目标路径.
+// 子模块显示为模式为 fs.ModeIrregular 的空条目.
+//
+// TreeFS 实现了 fs.ReadDirFS, fs.ReadFileFS 和 fs.StatFS, 因此可传递给
+// fs.WalkDir 等辅助函数. 它可以安全地并发使用.
+type TreeFS struct {
+	// contains filtered or unexported fields
+}
+
+// NewTreeFS returns a TreeFS for the tree of ref in the owner/repo
+// repository. ref may be a branch, a tag or a commit SHA.
+
+// NewTreeFS 返回 owner/repo 仓库中 ref 所指树的 TreeFS.
+// ref 可以是分支, 标签或提交 SHA.
+func NewTreeFS(client *Client, owner, repo, ref string) (*TreeFS, *Response, error)
+
+// Open opens the named file. It implements fs.FS.
+
+// Open 打开指定名称的文件. 它实现了 fs.FS.
+func (t *TreeFS) Open(name string) (fs.File, error)
+
+// ReadDir reads the named directory. It implements fs.ReadDirFS.
+
+// ReadDir 读取指定名称的目录. 它实现了 fs.ReadDirFS.
+func (t *TreeFS) ReadDir(name string) ([]fs.DirEntry, error)
+
+// ReadFile reads the named file. It implements fs.ReadFileFS.
+
+// ReadFile 读取指定名称的文件. 它实现了 fs.ReadFileFS.
+func (t *TreeFS) ReadFile(name string) ([]byte, error)
+
+// Stat returns a fs.FileInfo describing the named file without fetching its
+// content. It implements fs.StatFS.
+
+// Stat 返回描述指定名称文件的 fs.FileInfo, 不获取其内容. 它实现了 fs.StatFS.
+func (t *TreeFS) Stat(name string) (fs.FileInfo, error)
+
 // UnauthenticatedRateLimitedTransport allows you to make unauthenticated calls
 // that need to use a higher rate limit associated with your OAuth application.
 //
@@ -4875,6 +7230,14 @@ type User struct {
 	Collaborators     *int       `json:"collaborators,omitempty"`
 	Plan              *Plan      `json:"plan,omitempty"`
 
+	// Permissions is only populated for users returned by
+	// RepositoriesService.ListCollaborators, and describes their permissions
+	// on the repository, e.g. {"admin": false, "push": true, "pull": true}.
+
+	// Permissions 只为 RepositoriesService.ListCollaborators 返回的用户填充,
+	// 描述其在该仓库上的权限, 例如 {"admin": false, "push": true, "pull": true}.
+	Permissions *map[string]bool `json:"permissions,omitempty"`
+
 	// API URLs
 	URL               *string `json:"url,omitempty"`
 	EventsURL         *string `json:"events_url,omitempty"`